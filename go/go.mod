@@ -2,11 +2,13 @@ module interview_go
 
 go 1.25
 
-require golang.org/x/exp v0.0.0-20251009144603-d2f985daa21b
+require (
+	github.com/stretchr/testify v1.11.1
+	golang.org/x/exp v0.0.0-20251009144603-d2f985daa21b
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/stretchr/testify v1.11.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -29,7 +29,9 @@ type Point struct {
 // return: a list of k closest points
 //
 func kClosestPoints(points [][]int, k int) [][]int {
-    var resultHeap = heap.NewMinHeap[*Point](func(a, b *Point) int {
+    // Keep only the k closest points seen so far: O(n log k) time and O(k) memory,
+    // instead of pushing every point into a heap and popping k
+    var closest = heap.NewBoundedMinHeap[*Point](k, func(a, b *Point) int {
         return a.distance - b.distance
     })
 
@@ -46,15 +48,12 @@ func kClosestPoints(points [][]int, k int) [][]int {
     // Comparing x² + y² is enough because the point with the smaller x² + y² will also have the smaller sqrt(x² + y²).
 
     for _, point := range points {
-        resultHeap.Push(&Point{point[0], point[1], point[0]*point[0] + point[1]*point[1]})
+        closest.Offer(&Point{point[0], point[1], point[0]*point[0] + point[1]*point[1]})
     }
 
-    result := make([][]int, 0, k)
-    for i := 0; i < k; i++ {
-        point, ok := resultHeap.Pop()
-        if ok {
-            result = append(result, []int{point.x, point.y})
-        }
+    result := make([][]int, 0, closest.Size())
+    for _, point := range closest.Result() {
+        result = append(result, []int{point.x, point.y})
     }
 
     return result
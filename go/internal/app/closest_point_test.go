@@ -200,6 +200,19 @@ func TestKClosestPoints_MixedQuadrants(t *testing.T) {
     }
 }
 
+// TestKClosestPoints_ClosestFirst tests that the result is ordered by distance, on many points
+func TestKClosestPoints_ClosestFirst(t *testing.T) {
+    points := make([][]int, 0, 10000)
+    for i := 10000; i > 0; i-- {
+        points = append(points, []int{i, -i})
+    }
+    k := 5
+
+    result := kClosestPoints(points, k)
+
+    assert.Equal(t, [][]int{{1, -1}, {2, -2}, {3, -3}, {4, -4}, {5, -5}}, result, "Should return the closest points first")
+}
+
 // Helper function to sort points for consistent comparison
 func sortPoints(points [][]int) {
     sort.Slice(points, func(i, j int) bool {
@@ -1,6 +1,10 @@
 package collection
 
-import "interview_go/internal/util/iterator"
+import (
+	"iter"
+
+	"interview_go/internal/util/iterator"
+)
 
 // Collection is the generic interface that defines the contract for a collection
 // with focus on read-only methods (no mutators)
@@ -12,6 +16,12 @@ type Collection[T any] interface {
 	// GetFromEnd retrieves the element at the specified position, counting backwards from the end, if possible.
 	GetFromEnd(index int) (T, bool)
 
+	// IndexOf returns the position of the first element equal to value, according to equals, or -1.
+	IndexOf(value T, equals func(a, b T) bool) int
+
+	// Contains checks if the list has an element equal to value, according to equals.
+	Contains(value T, equals func(a, b T) bool) bool
+
 	// Size returns the number of elements in the list.
 	Size() int
 
@@ -26,4 +36,7 @@ type Collection[T any] interface {
 
 	// Iterator returns an iterator over elements of type T.
 	Iterator() iterator.Iterator[T]
+
+	// All returns a sequence over the elements, in the same order as Iterator, for use with range.
+	All() iter.Seq[T]
 }
@@ -0,0 +1,213 @@
+package concurrent
+
+import (
+    "context"
+    "fmt"
+    "sync"
+
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/heap"
+)
+
+// errClosed is returned by the operations of a closed BlockingPriorityQueue
+var errClosed = fmt.Errorf("concurrent: queue: %w", errs.ErrClosed)
+
+// BlockingPriorityQueue is a thread-safe priority queue where consumers block until an
+// element is available, instead of spinning on an empty heap.
+//
+//   producers                                 consumers
+//   Put(ctx, job) ──→  [ heap ordered by  ]  ──→ Take(ctx)
+//   (blocks when full)  [ the comparator   ]     (blocks when empty)
+//
+// The queue can be bounded: when it holds capacity elements, Put blocks until a consumer
+// makes room. Both blocking operations take a context, so waiting can be cancelled or timed out.
+//
+// Close stops the producers (Put returns an error wrapping errs.ErrClosed), while consumers
+// keep taking the remaining elements; once the queue is empty, Take returns the error too,
+// so consumer loops end cleanly:
+//
+//   for {
+//       job, err := queue.Take(ctx)
+//       if err != nil {
+//           return // closed and drained, or ctx cancelled
+//       }
+//       job.Run()
+//   }
+type BlockingPriorityQueue[T any] struct {
+    mu       sync.Mutex
+    heap     heap.Heap[T]
+    capacity int // Maximum number of elements, 0 for unbounded
+    closed   bool
+
+    // Waiting is done on channels, not on a sync.Cond, so it can be combined with ctx.Done().
+    // A channel is closed (waking every waiter) and replaced whenever its condition may hold.
+    notEmpty chan struct{}
+    notFull  chan struct{}
+}
+
+// NewBlockingPriorityQueue wraps h, which decides the priority order (min or max heap) and
+// must not be used directly afterward. A capacity <= 0 makes the queue unbounded.
+func NewBlockingPriorityQueue[T any](h heap.Heap[T], capacity int) *BlockingPriorityQueue[T] {
+    if capacity < 0 {
+        capacity = 0
+    }
+    return &BlockingPriorityQueue[T]{
+        heap:     h,
+        capacity: capacity,
+        notEmpty: make(chan struct{}),
+        notFull:  make(chan struct{}),
+    }
+}
+
+// broadcast wakes every goroutine waiting on ch, and returns a fresh channel for the next wait
+func broadcast(ch chan struct{}) chan struct{} {
+    close(ch)
+    return make(chan struct{})
+}
+
+// isFull must be called with the lock held
+func (q *BlockingPriorityQueue[T]) isFull() bool {
+    return q.capacity > 0 && q.heap.Size() >= q.capacity
+}
+
+// Put adds value to the queue, blocking while the queue is full.
+// Returns an error wrapping errs.ErrClosed if the queue is closed (even while waiting),
+// or the context error if ctx is done before there is room.
+func (q *BlockingPriorityQueue[T]) Put(ctx context.Context, value T) error {
+    for {
+        q.mu.Lock()
+        if q.closed {
+            q.mu.Unlock()
+            return errClosed
+        }
+        if !q.isFull() {
+            q.heap.Push(value)
+            q.notEmpty = broadcast(q.notEmpty)
+            q.mu.Unlock()
+            return nil
+        }
+        wait := q.notFull
+        q.mu.Unlock()
+
+        select {
+        case <-wait:
+            // Something changed, check again
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+    }
+}
+
+// TryPut adds value without blocking. Returns false if the queue is full or closed.
+func (q *BlockingPriorityQueue[T]) TryPut(value T) bool {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    if q.closed || q.isFull() {
+        return false
+    }
+    q.heap.Push(value)
+    q.notEmpty = broadcast(q.notEmpty)
+    return true
+}
+
+// Take removes and returns the element with the highest priority, blocking while the queue is empty.
+// Returns an error wrapping errs.ErrClosed if the queue is closed and empty,
+// or the context error if ctx is done before an element is available.
+func (q *BlockingPriorityQueue[T]) Take(ctx context.Context) (T, error) {
+    for {
+        q.mu.Lock()
+        if value, ok := q.heap.Pop(); ok {
+            q.notFull = broadcast(q.notFull)
+            q.mu.Unlock()
+            return value, nil
+        }
+        if q.closed {
+            q.mu.Unlock()
+            return *new(T), errClosed
+        }
+        wait := q.notEmpty
+        q.mu.Unlock()
+
+        select {
+        case <-wait:
+            // Something changed, check again
+        case <-ctx.Done():
+            return *new(T), ctx.Err()
+        }
+    }
+}
+
+// TryTake removes and returns the element with the highest priority without blocking.
+// Returns false if the queue is empty.
+func (q *BlockingPriorityQueue[T]) TryTake() (T, bool) {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    value, ok := q.heap.Pop()
+    if ok {
+        q.notFull = broadcast(q.notFull)
+    }
+    return value, ok
+}
+
+// Drain removes, without blocking, up to n elements in priority order (all of them if n <= 0).
+// Useful to process jobs in batches, or to collect what is left after Close.
+func (q *BlockingPriorityQueue[T]) Drain(n int) []T {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    if n <= 0 || n > q.heap.Size() {
+        n = q.heap.Size()
+    }
+    result := make([]T, 0, n)
+    for len(result) < n {
+        value, _ := q.heap.Pop()
+        result = append(result, value)
+    }
+    if n > 0 {
+        q.notFull = broadcast(q.notFull)
+    }
+    return result
+}
+
+// Peek returns the element with the highest priority without removing it.
+func (q *BlockingPriorityQueue[T]) Peek() (T, bool) {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    return q.heap.Peek()
+}
+
+// Size returns the number of elements waiting in the queue.
+func (q *BlockingPriorityQueue[T]) Size() int {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    return q.heap.Size()
+}
+
+// IsEmpty checks if there are no elements waiting in the queue.
+func (q *BlockingPriorityQueue[T]) IsEmpty() bool {
+    return q.Size() == 0
+}
+
+// Capacity returns the maximum number of elements, 0 if unbounded.
+func (q *BlockingPriorityQueue[T]) Capacity() int {
+    return q.capacity
+}
+
+// Close stops accepting elements and wakes every blocked Put and Take.
+// Remaining elements can still be taken. Closing twice is a no-op.
+func (q *BlockingPriorityQueue[T]) Close() {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    if q.closed {
+        return
+    }
+    q.closed = true
+    q.notEmpty = broadcast(q.notEmpty)
+    q.notFull = broadcast(q.notFull)
+}
+
+// IsClosed checks if Close was called.
+func (q *BlockingPriorityQueue[T]) IsClosed() bool {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    return q.closed
+}
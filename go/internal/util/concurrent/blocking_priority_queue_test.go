@@ -0,0 +1,220 @@
+package concurrent
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/heap"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func newBlockingQueue(capacity int) *BlockingPriorityQueue[int] {
+    return NewBlockingPriorityQueue[int](heap.NewMinHeap[int](func(a, b int) int {
+        return a - b
+    }), capacity)
+}
+
+// TestBlockingPriorityQueue_PriorityOrder tests that Take follows the heap order
+func TestBlockingPriorityQueue_PriorityOrder(t *testing.T) {
+    q := newBlockingQueue(0)
+    ctx := context.Background()
+    for _, v := range []int{5, 1, 4, 2, 3} {
+        assert.NoError(t, q.Put(ctx, v))
+    }
+    assert.Equal(t, 5, q.Size())
+
+    for i := 1; i <= 5; i++ {
+        v, err := q.Take(ctx)
+        assert.NoError(t, err)
+        assert.Equal(t, i, v)
+    }
+    assert.True(t, q.IsEmpty())
+}
+
+// TestBlockingPriorityQueue_TakeBlocksUntilPut tests that a consumer waits for a producer
+func TestBlockingPriorityQueue_TakeBlocksUntilPut(t *testing.T) {
+    q := newBlockingQueue(0)
+    result := make(chan int)
+    go func() {
+        v, _ := q.Take(context.Background())
+        result <- v
+    }()
+
+    select {
+    case <-result:
+        t.Fatal("Take should block on an empty queue")
+    case <-time.After(20 * time.Millisecond):
+    }
+
+    assert.True(t, q.TryPut(42))
+    select {
+    case v := <-result:
+        assert.Equal(t, 42, v)
+    case <-time.After(time.Second):
+        t.Fatal("Take should unblock after Put")
+    }
+}
+
+// TestBlockingPriorityQueue_TakeCancelled tests that waiting honors the context
+func TestBlockingPriorityQueue_TakeCancelled(t *testing.T) {
+    q := newBlockingQueue(0)
+    ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+    defer cancel()
+
+    _, err := q.Take(ctx)
+    assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestBlockingPriorityQueue_BoundedPut tests that Put blocks while the queue is full
+func TestBlockingPriorityQueue_BoundedPut(t *testing.T) {
+    q := newBlockingQueue(2)
+    ctx := context.Background()
+    assert.Equal(t, 2, q.Capacity())
+    assert.NoError(t, q.Put(ctx, 1))
+    assert.NoError(t, q.Put(ctx, 2))
+    assert.False(t, q.TryPut(3), "TryPut should fail on a full queue")
+
+    // A blocked Put can be cancelled
+    timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+    defer cancel()
+    assert.ErrorIs(t, q.Put(timeout, 3), context.DeadlineExceeded)
+
+    // A blocked Put resumes once a consumer makes room
+    done := make(chan error)
+    go func() {
+        done <- q.Put(ctx, 0)
+    }()
+    select {
+    case <-done:
+        t.Fatal("Put should block on a full queue")
+    case <-time.After(20 * time.Millisecond):
+    }
+
+    v, ok := q.TryTake()
+    assert.True(t, ok)
+    assert.Equal(t, 1, v)
+    assert.NoError(t, <-done)
+
+    v, _ = q.Peek()
+    assert.Equal(t, 0, v)
+    assert.Equal(t, 2, q.Size())
+}
+
+// TestBlockingPriorityQueue_Close tests that Close unblocks everybody and drains cleanly
+func TestBlockingPriorityQueue_Close(t *testing.T) {
+    t.Run("UnblocksConsumers", func(t *testing.T) {
+        q := newBlockingQueue(0)
+        var wg sync.WaitGroup
+        results := make(chan error, goroutines)
+        for i := 0; i < goroutines; i++ {
+            wg.Add(1)
+            go func() {
+                defer wg.Done()
+                _, err := q.Take(context.Background())
+                results <- err
+            }()
+        }
+        time.Sleep(10 * time.Millisecond)
+        q.Close()
+        wg.Wait()
+        close(results)
+        for err := range results {
+            assert.ErrorIs(t, err, errs.ErrClosed)
+        }
+    })
+
+    t.Run("UnblocksProducers", func(t *testing.T) {
+        q := newBlockingQueue(1)
+        q.TryPut(1)
+        done := make(chan error)
+        go func() {
+            done <- q.Put(context.Background(), 2)
+        }()
+        time.Sleep(10 * time.Millisecond)
+        q.Close()
+        assert.ErrorIs(t, <-done, errs.ErrClosed)
+    })
+
+    t.Run("RemainingElementsCanBeTaken", func(t *testing.T) {
+        q := newBlockingQueue(0)
+        q.TryPut(2)
+        q.TryPut(1)
+        q.Close()
+        q.Close() // no-op
+        assert.True(t, q.IsClosed())
+
+        assert.ErrorIs(t, q.Put(context.Background(), 3), errs.ErrClosed)
+        assert.False(t, q.TryPut(3))
+
+        v, err := q.Take(context.Background())
+        assert.NoError(t, err)
+        assert.Equal(t, 1, v)
+        assert.Equal(t, []int{2}, q.Drain(0))
+
+        _, err = q.Take(context.Background())
+        assert.ErrorIs(t, err, errs.ErrClosed)
+    })
+}
+
+// TestBlockingPriorityQueue_Drain tests batch removal
+func TestBlockingPriorityQueue_Drain(t *testing.T) {
+    q := newBlockingQueue(0)
+    for _, v := range []int{5, 3, 1, 4, 2} {
+        q.TryPut(v)
+    }
+    assert.Equal(t, []int{1, 2}, q.Drain(2))
+    assert.Equal(t, []int{3, 4, 5}, q.Drain(10))
+    assert.Empty(t, q.Drain(0))
+}
+
+// TestBlockingPriorityQueue_ProducersConsumers hammers a bounded queue, checking nothing is lost
+func TestBlockingPriorityQueue_ProducersConsumers(t *testing.T) {
+    q := newBlockingQueue(16)
+    ctx := context.Background()
+    taken := make([][]int, goroutines)
+
+    var consumers sync.WaitGroup
+    for c := 0; c < goroutines/2; c++ {
+        consumers.Add(1)
+        go func() {
+            defer consumers.Done()
+            for {
+                v, err := q.Take(ctx)
+                if err != nil {
+                    return
+                }
+                taken[c] = append(taken[c], v)
+            }
+        }()
+    }
+
+    var producers sync.WaitGroup
+    for p := 0; p < goroutines/2; p++ {
+        producers.Add(1)
+        go func() {
+            defer producers.Done()
+            for i := 0; i < perRoutine; i++ {
+                assert.NoError(t, q.Put(ctx, p*perRoutine+i))
+            }
+        }()
+    }
+
+    producers.Wait()
+    q.Close()
+    consumers.Wait()
+
+    seen := make(map[int]int)
+    for _, values := range taken {
+        for _, v := range values {
+            seen[v]++
+        }
+    }
+    assert.Len(t, seen, goroutines/2*perRoutine)
+    for v, count := range seen {
+        assert.Equal(t, 1, count, "Element %d taken %d times", v, count)
+    }
+}
@@ -0,0 +1,28 @@
+// Package concurrent provides thread-safe wrappers for the containers in internal/util.
+//
+// Every wrapper guards an existing implementation with a sync.RWMutex and implements the same
+// interface, so it can be swapped in wherever the plain container is used:
+//
+//    var queue heap.Heap[*Job] = concurrent.NewHeap[*Job](heap.NewMinHeap(byPriority))
+//
+// Mutators take the write lock, read-only methods take the read lock, so many readers can
+// proceed in parallel.
+//
+// Iterators (and range-over-func sequences) are snapshots: the elements are copied while
+// holding the read lock, and the traversal then runs without any lock. A slow consumer never
+// blocks writers, and the traversal never observes a half-applied modification, at the cost
+// of an O(n) copy per iterator and not seeing later changes.
+package concurrent
+
+import (
+    "iter"
+    "slices"
+    "sync"
+)
+
+// snapshot copies a sequence into a slice while holding the read lock
+func snapshot[T any](mu *sync.RWMutex, seq iter.Seq[T]) []T {
+    mu.RLock()
+    defer mu.RUnlock()
+    return slices.Collect(seq)
+}
@@ -0,0 +1,35 @@
+package concurrent
+
+import (
+    "sync"
+    "testing"
+)
+
+// Hammer tests are meant to be run with the race detector:
+//
+//    go test -race ./internal/util/concurrent/
+//
+// Without it they still check that no element is lost or duplicated.
+
+const (
+    goroutines = 16
+    perRoutine = 500
+)
+
+// hammer runs worker in many goroutines at the same time, passing the goroutine number
+func hammer(t *testing.T, worker func(g int)) {
+    t.Helper()
+    var start, done sync.WaitGroup
+    start.Add(1)
+    for g := 0; g < goroutines; g++ {
+        done.Add(1)
+        go func() {
+            defer done.Done()
+            // Release all goroutines at once, to maximize contention
+            start.Wait()
+            worker(g)
+        }()
+    }
+    start.Done()
+    done.Wait()
+}
@@ -0,0 +1,125 @@
+package concurrent
+
+import (
+    "iter"
+    "slices"
+    "sync"
+
+    "interview_go/internal/util/heap"
+    "interview_go/internal/util/iterator"
+)
+
+// Heap is a thread-safe wrapper of a heap.Heap, typically used as a priority queue shared
+// by several workers. Pop is atomic: when it returns true, no other goroutine got the same element.
+type Heap[T any] struct {
+    mu    sync.RWMutex
+    inner heap.Heap[T]
+}
+
+// NewHeap wraps inner, which must not be used directly afterward.
+func NewHeap[T any](inner heap.Heap[T]) *Heap[T] {
+    return &Heap[T]{inner: inner}
+}
+
+var _ heap.Heap[any] = (*Heap[any])(nil)
+
+func (h *Heap[T]) Peek() (T, bool) {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+    return h.inner.Peek()
+}
+
+func (h *Heap[T]) Push(value T) {
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    h.inner.Push(value)
+}
+
+func (h *Heap[T]) Pop() (T, bool) {
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    return h.inner.Pop()
+}
+
+func (h *Heap[T]) Size() int {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+    return h.inner.Size()
+}
+
+func (h *Heap[T]) IsEmpty() bool {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+    return h.inner.IsEmpty()
+}
+
+func (h *Heap[T]) Clear() {
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    h.inner.Clear()
+}
+
+func (h *Heap[T]) Heapify(elements []T) {
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    h.inner.Heapify(elements)
+}
+
+// Merge moves all the elements of other into the wrapped heap, leaving other empty.
+// The elements of other are detached first, so the two locks are never held together and two
+// goroutines merging a pair of concurrent heaps in opposite directions cannot deadlock.
+func (h *Heap[T]) Merge(other heap.Heap[T]) {
+    if other == nil || other == heap.Heap[T](h) {
+        return
+    }
+
+    var detached *heap.ImplHeap[T]
+    if shared, ok := other.(*Heap[T]); ok {
+        shared.mu.Lock()
+        detached = shared.inner.Clone()
+        shared.inner.Clear()
+        shared.mu.Unlock()
+    } else {
+        detached = other.Clone()
+        other.Clear()
+    }
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    h.inner.Merge(detached)
+}
+
+// ToSlice returns a copy of the elements in the underlying array order, so it is safe to keep.
+func (h *Heap[T]) ToSlice() []T {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+    // heap.Heap guarantees a new slice, owned by the caller
+    return h.inner.ToSlice()
+}
+
+// Iterator traverses a snapshot, in the underlying array order.
+func (h *Heap[T]) Iterator() iterator.Iterator[T] {
+    return iterator.FromSlice(h.ToSlice())
+}
+
+// SortedIterator traverses a clone taken under the read lock, in priority order.
+func (h *Heap[T]) SortedIterator() iterator.Iterator[T] {
+    return h.Clone().SortedIterator()
+}
+
+// All ranges over a snapshot, in the underlying array order.
+func (h *Heap[T]) All() iter.Seq[T] {
+    return slices.Values(h.ToSlice())
+}
+
+// Sorted ranges over a clone taken under the read lock, in priority order.
+func (h *Heap[T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
+// Clone returns a plain (not thread-safe) copy of the heap.
+func (h *Heap[T]) Clone() *heap.ImplHeap[T] {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+    return h.inner.Clone()
+}
@@ -0,0 +1,133 @@
+package concurrent
+
+import (
+    "slices"
+    "testing"
+
+    "interview_go/internal/util/heap"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func newMinHeap() *Heap[int] {
+    return NewHeap[int](heap.NewMinHeap[int](func(a, b int) int {
+        return a - b
+    }))
+}
+
+// TestHeap_ConcurrentWorkers simulates producers and consumers sharing a priority queue
+func TestHeap_ConcurrentWorkers(t *testing.T) {
+    h := newMinHeap()
+    popped := make([][]int, goroutines)
+
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine; i++ {
+            if g%2 == 0 {
+                h.Push(g*perRoutine + i)
+                continue
+            }
+            if val, ok := h.Pop(); ok {
+                popped[g] = append(popped[g], val)
+            }
+            h.Peek()
+            h.Size()
+            for range h.All() {
+            }
+        }
+    })
+
+    seen := make(map[int]int)
+    for _, values := range popped {
+        // Producers interleave, so only uniqueness can be checked, not the order
+        for _, v := range values {
+            seen[v]++
+        }
+    }
+    for v := range h.All() {
+        seen[v]++
+    }
+    assert.Len(t, seen, goroutines/2*perRoutine)
+    for v, count := range seen {
+        assert.Equal(t, 1, count, "Element %d seen %d times", v, count)
+    }
+}
+
+// TestHeap_SnapshotIterators checks that iterators don't see, nor block, later modifications
+func TestHeap_SnapshotIterators(t *testing.T) {
+    h := newMinHeap()
+    h.Heapify([]int{5, 3, 8, 1})
+
+    it := h.Iterator()
+    sorted := h.SortedIterator()
+    slice := h.ToSlice()
+
+    // Modify the heap while the iterators are alive (would deadlock if they held the lock)
+    h.Push(0)
+    h.Pop()
+    h.Pop()
+    slice[0] = 100
+
+    var values []int
+    for it.HasNext() {
+        values = append(values, it.Next())
+    }
+    assert.ElementsMatch(t, []int{5, 3, 8, 1}, values)
+
+    var ordered []int
+    for sorted.HasNext() {
+        ordered = append(ordered, sorted.Next())
+    }
+    assert.Equal(t, []int{1, 3, 5, 8}, ordered)
+
+    val, _ := h.Peek()
+    assert.Equal(t, 3, val, "ToSlice should return a copy")
+    assert.Equal(t, []int{3, 5, 8}, slices.Collect(h.Sorted()))
+}
+
+// TestHeap_ConcurrentSortedIterators runs SortedIterator while pushing
+func TestHeap_ConcurrentSortedIterators(t *testing.T) {
+    h := newMinHeap()
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine/10; i++ {
+            h.Push(i)
+            prev := -1
+            for v := range h.Sorted() {
+                assert.GreaterOrEqual(t, v, prev)
+                prev = v
+            }
+            h.Clone()
+        }
+    })
+    assert.Equal(t, goroutines*perRoutine/10, h.Size())
+}
+
+// TestHeap_CrossMerge merges two shared heaps into each other concurrently, which would deadlock
+// if Merge held both locks
+func TestHeap_CrossMerge(t *testing.T) {
+    a, b := newMinHeap(), newMinHeap()
+
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine; i++ {
+            if g%2 == 0 {
+                a.Push(g*perRoutine + i)
+                a.Merge(b)
+            } else {
+                b.Push(g*perRoutine + i)
+                b.Merge(a)
+            }
+        }
+    })
+
+    a.Merge(b)
+    assert.True(t, b.IsEmpty())
+    assert.Equal(t, goroutines*perRoutine, a.Size(), "no element should be lost or duplicated")
+
+    var previous int
+    for i := 0; !a.IsEmpty(); i++ {
+        value, _ := a.Pop()
+        if i > 0 {
+            assert.LessOrEqual(t, previous, value)
+        }
+        previous = value
+    }
+}
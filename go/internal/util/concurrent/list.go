@@ -0,0 +1,162 @@
+package concurrent
+
+import (
+    "iter"
+    "sync"
+
+    "interview_go/internal/util/collection"
+    "interview_go/internal/util/iterator"
+    "interview_go/internal/util/list"
+)
+
+// ListCollection is a list exposing both the mutators (list.List) and the read-only
+// methods (collection.Collection), like list.DoubleLinkedList.
+type ListCollection[T any] interface {
+    list.List[T]
+    collection.Collection[T]
+}
+
+// List is a thread-safe wrapper of a ListCollection.
+//
+// Callbacks (the equals of IndexOf/Contains, the predicate of RemoveIf) run while holding
+// the lock, so they must not call back into the same List.
+type List[T any] struct {
+    mu    sync.RWMutex
+    inner ListCollection[T]
+}
+
+// NewList wraps inner, which must not be used directly afterward.
+func NewList[T any](inner ListCollection[T]) *List[T] {
+    return &List[T]{inner: inner}
+}
+
+// Compilation assert: force contract
+var _ ListCollection[any] = (*List[any])(nil)
+
+// -- list.List --
+
+func (l *List[T]) Add(value T) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    l.inner.Add(value)
+}
+
+func (l *List[T]) AddFirst(value T) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    l.inner.AddFirst(value)
+}
+
+func (l *List[T]) Insert(index int, value T) bool {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.Insert(index, value)
+}
+
+func (l *List[T]) Set(index int, value T) bool {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.Set(index, value)
+}
+
+func (l *List[T]) Clear() {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    l.inner.Clear()
+}
+
+func (l *List[T]) Remove(index int) bool {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.Remove(index)
+}
+
+func (l *List[T]) RemoveFromEnd() (T, bool) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.RemoveFromEnd()
+}
+
+func (l *List[T]) RemoveFirst() (T, bool) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.RemoveFirst()
+}
+
+func (l *List[T]) RemoveIf(predicate func(T) bool) int {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.inner.RemoveIf(predicate)
+}
+
+func (l *List[T]) Reverse() {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    l.inner.Reverse()
+}
+
+// -- collection.Collection --
+
+func (l *List[T]) Get(index int) (T, bool) {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.Get(index)
+}
+
+func (l *List[T]) GetFromEnd(index int) (T, bool) {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.GetFromEnd(index)
+}
+
+func (l *List[T]) IndexOf(value T, equals func(a, b T) bool) int {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.IndexOf(value, equals)
+}
+
+func (l *List[T]) Contains(value T, equals func(a, b T) bool) bool {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.Contains(value, equals)
+}
+
+func (l *List[T]) Size() int {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.Size()
+}
+
+func (l *List[T]) IsEmpty() bool {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.IsEmpty()
+}
+
+func (l *List[T]) End() (T, bool) {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.End()
+}
+
+func (l *List[T]) Start() (T, bool) {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+    return l.inner.Start()
+}
+
+// Iterator traverses a snapshot, from the start to the end of the list.
+func (l *List[T]) Iterator() iterator.Iterator[T] {
+    return iterator.FromSlice(snapshot(&l.mu, l.inner.All()))
+}
+
+// All ranges over a snapshot, from the start to the end of the list.
+func (l *List[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, value := range snapshot(&l.mu, l.inner.All()) {
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
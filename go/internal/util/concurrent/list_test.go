@@ -0,0 +1,83 @@
+package concurrent
+
+import (
+    "slices"
+    "testing"
+
+    "interview_go/internal/util/list"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestList_ConcurrentDeque uses the list as a shared deque: producers add at both ends,
+// consumers remove from both ends
+func TestList_ConcurrentDeque(t *testing.T) {
+    l := NewList[int](list.NewDoubleLinkedList[int]())
+    removed := make([][]int, goroutines)
+
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine; i++ {
+            value := g*perRoutine + i
+            switch g % 4 {
+            case 0:
+                l.Add(value)
+            case 1:
+                l.AddFirst(value)
+            case 2:
+                if v, ok := l.RemoveFirst(); ok {
+                    removed[g] = append(removed[g], v)
+                }
+            case 3:
+                if v, ok := l.RemoveFromEnd(); ok {
+                    removed[g] = append(removed[g], v)
+                }
+                l.Get(l.Size() / 2)
+                l.Contains(value, func(a, b int) bool { return a == b })
+                for range l.All() {
+                }
+            }
+        }
+    })
+
+    seen := make(map[int]int)
+    for _, values := range removed {
+        for _, v := range values {
+            seen[v]++
+        }
+    }
+    for it := l.Iterator(); it.HasNext(); {
+        seen[it.Next()]++
+    }
+    assert.Len(t, seen, goroutines/2*perRoutine)
+    for v, count := range seen {
+        assert.Equal(t, 1, count, "Element %d seen %d times", v, count)
+    }
+}
+
+// TestList_Contract checks the wrapper behaves like the wrapped list
+func TestList_Contract(t *testing.T) {
+    l := NewList[int](list.NewDoubleLinkedList[int]())
+    l.Add(2)
+    l.AddFirst(1)
+    l.Insert(2, 4)
+    l.Insert(2, 3)
+    assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(l.All()))
+
+    l.Set(0, 10)
+    l.Reverse()
+    assert.Equal(t, []int{4, 3, 2, 10}, slices.Collect(l.All()))
+    assert.Equal(t, 1, l.RemoveIf(func(v int) bool { return v == 3 }))
+    assert.True(t, l.Remove(0))
+
+    start, _ := l.Start()
+    end, _ := l.End()
+    last, _ := l.GetFromEnd(0)
+    assert.Equal(t, 2, start)
+    assert.Equal(t, 10, end)
+    assert.Equal(t, 10, last)
+    assert.Equal(t, 1, l.IndexOf(10, func(a, b int) bool { return a == b }))
+    assert.Equal(t, 2, l.Size())
+
+    l.Clear()
+    assert.True(t, l.IsEmpty())
+}
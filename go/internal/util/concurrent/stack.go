@@ -0,0 +1,69 @@
+package concurrent
+
+import (
+    "iter"
+    "sync"
+
+    "interview_go/internal/util/stack"
+)
+
+// Stack is a thread-safe wrapper of a stack.Stack.
+// Compound "check then act" sequences (IsEmpty then Pop) are not atomic: use TryPop instead.
+type Stack[T any] struct {
+    mu    sync.RWMutex
+    inner stack.Stack[T]
+}
+
+// NewStack wraps inner, which must not be used directly afterward.
+func NewStack[T any](inner stack.Stack[T]) *Stack[T] {
+    return &Stack[T]{inner: inner}
+}
+
+var _ stack.Stack[any] = (*Stack[any])(nil)
+
+func (s *Stack[T]) Push(t T) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.inner.Push(t)
+}
+
+func (s *Stack[T]) Pop() T {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.inner.Pop()
+}
+
+func (s *Stack[T]) TryPop() (T, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.inner.TryPop()
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.inner.Peek()
+}
+
+func (s *Stack[T]) Size() int {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.inner.Size()
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.inner.IsEmpty()
+}
+
+// All ranges over a snapshot, from the top to the bottom of the stack.
+func (s *Stack[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, value := range snapshot(&s.mu, s.inner.All()) {
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
@@ -0,0 +1,61 @@
+package concurrent
+
+import (
+    "testing"
+
+    "interview_go/internal/util/stack"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestStack_ConcurrentPushPop pushes from half of the goroutines and pops from the other half,
+// while ranging over snapshots
+func TestStack_ConcurrentPushPop(t *testing.T) {
+    s := NewStack[int](stack.NewArrayStack[int](0))
+    popped := make([][]int, goroutines)
+
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine; i++ {
+            if g%2 == 0 {
+                s.Push(g*perRoutine + i)
+                continue
+            }
+            if val, err := s.TryPop(); err == nil {
+                popped[g] = append(popped[g], val)
+            }
+            s.Peek()
+            for range s.All() {
+            }
+        }
+    })
+
+    // Every pushed element is either still in the stack or popped exactly once
+    seen := make(map[int]int)
+    for _, values := range popped {
+        for _, v := range values {
+            seen[v]++
+        }
+    }
+    for v := range s.All() {
+        seen[v]++
+    }
+    assert.Len(t, seen, goroutines/2*perRoutine)
+    for v, count := range seen {
+        assert.Equal(t, 1, count, "Element %d seen %d times", v, count)
+    }
+}
+
+// TestStack_Contract checks the wrapper behaves like the wrapped stack
+func TestStack_Contract(t *testing.T) {
+    var s stack.Stack[string] = NewStack[string](stack.NewDoubleLinkedListStack[string]())
+    assert.True(t, s.IsEmpty())
+    s.Push("a")
+    s.Push("b")
+    assert.Equal(t, 2, s.Size())
+    top, _ := s.Peek()
+    assert.Equal(t, "b", top)
+    assert.Equal(t, "b", s.Pop())
+    assert.Equal(t, "a", s.Pop())
+    _, err := s.TryPop()
+    assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package concurrent
+
+import (
+    "iter"
+    "sync"
+
+    "interview_go/internal/util/iterator"
+    "interview_go/internal/util/tree"
+)
+
+// Tree is a thread-safe wrapper of a tree.Tree, e.g. a BST index shared by several workers.
+type Tree[T any] struct {
+    mu    sync.RWMutex
+    inner tree.Tree[T]
+}
+
+// NewTree wraps inner, which must not be used directly afterward.
+func NewTree[T any](inner tree.Tree[T]) *Tree[T] {
+    return &Tree[T]{inner: inner}
+}
+
+var _ tree.Tree[any] = (*Tree[any])(nil)
+
+func (t *Tree[T]) Root() (T, bool) {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    return t.inner.Root()
+}
+
+func (t *Tree[T]) Add(value T) {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+    t.inner.Add(value)
+}
+
+func (t *Tree[T]) Search(key T) (T, bool) {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    return t.inner.Search(key)
+}
+
+func (t *Tree[T]) Remove(value T) (T, bool) {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+    return t.inner.Remove(value)
+}
+
+func (t *Tree[T]) Clear() {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+    t.inner.Clear()
+}
+
+// Iterator traverses a snapshot, in the order of the wrapped tree's iterator.
+func (t *Tree[T]) Iterator() tree.Iterator[T] {
+    return iterator.FromSlice(snapshot(&t.mu, t.inner.All()))
+}
+
+// All ranges over a snapshot, in the order of the wrapped tree's iterator.
+func (t *Tree[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, value := range snapshot(&t.mu, t.inner.All()) {
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
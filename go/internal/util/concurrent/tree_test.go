@@ -0,0 +1,56 @@
+package concurrent
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+
+    "interview_go/internal/util/tree"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestTree_ConcurrentAddAndIterate adds distinct values from every goroutine, while
+// iterating and searching
+func TestTree_ConcurrentAddAndIterate(t *testing.T) {
+    index := NewTree[int](tree.NewBinaryTree[int]())
+    // Shuffled values keep the unbalanced tree reasonably shallow
+    values := rand.New(rand.NewSource(42)).Perm(goroutines * perRoutine)
+
+    hammer(t, func(g int) {
+        for i := 0; i < perRoutine; i++ {
+            index.Add(values[g*perRoutine+i])
+            if i%50 == 0 {
+                it := index.Iterator()
+                prev := -1
+                for it.HasNext() {
+                    v := it.Next()
+                    assert.Greater(t, v, prev, "Snapshot should be sorted")
+                    prev = v
+                }
+            }
+            index.Search(i)
+            index.Root()
+        }
+    })
+
+    result := slices.Collect(index.All())
+    assert.Len(t, result, goroutines*perRoutine)
+    assert.True(t, slices.IsSorted(result))
+}
+
+// TestTree_SnapshotIsolation checks that a snapshot does not see later modifications
+func TestTree_SnapshotIsolation(t *testing.T) {
+    index := NewTree[int](tree.NewBinaryTree[int]())
+    index.Add(2)
+    index.Add(1)
+
+    it := index.Iterator()
+    index.Add(3)
+    index.Clear()
+
+    assert.Equal(t, 1, it.Next())
+    assert.Equal(t, 2, it.Next())
+    assert.False(t, it.HasNext())
+    assert.Empty(t, slices.Collect(index.All()))
+}
@@ -0,0 +1,40 @@
+// Package errs holds the sentinel errors shared by the containers in internal/util.
+//
+// Operations that cannot return a (T, bool) pair (Stack.Pop, Iterator.Next, ...) panic with an
+// error wrapping one of these sentinels, so callers can recover and test it with errors.Is:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			if err, ok := r.(error); ok && errors.Is(err, errs.ErrEmpty) {
+//				...
+//			}
+//		}
+//	}()
+//
+// Most containers also offer a non-panicking variant (TryPop, iterator.TryNext, TryGet)
+// returning these errors directly.
+package errs
+
+import "errors"
+
+var (
+	// ErrEmpty is reported when removing from an empty container (e.g. Pop on an empty stack).
+	ErrEmpty = errors.New("empty")
+
+	// ErrExhausted is reported when calling Next on an iterator with no more elements.
+	ErrExhausted = errors.New("no more elements")
+
+	// ErrIndexOutOfRange is reported when accessing a position outside [0, Size()).
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrConcurrentModification is reported by fail-fast iterators when their container
+	// was structurally modified behind their back.
+	ErrConcurrentModification = errors.New("concurrent modification")
+
+	// ErrClosed is reported when using a container that was closed (e.g. a blocking queue).
+	ErrClosed = errors.New("closed")
+
+	// ErrInvariant is reported when the internal invariant of a container does not hold, e.g. a
+	// heap built with an inconsistent comparator, or whose elements were mutated in place.
+	ErrInvariant = errors.New("invariant violated")
+)
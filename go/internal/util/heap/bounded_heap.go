@@ -0,0 +1,120 @@
+package heap
+
+import (
+    "iter"
+    "slices"
+)
+
+// BoundedHeap keeps the best k elements of a stream, using O(k) memory.
+//
+// For the k smallest elements, the kept ones are stored in a max-heap: the root is the worst of
+// the current best, and the threshold a new element has to beat.
+//
+// Example keeping the 3 smallest of 7, 2, 9, 4, 1:
+//
+//   Offer(7), Offer(2), Offer(9) -> full, root 9
+//   Offer(4) -> 4 < 9, evict 9, root 7
+//   Offer(1) -> 1 < 7, evict 7, root 4
+//   Result()  -> [1, 2, 4]
+//
+// Every Offer is O(log k), so the best k of n elements cost O(n log k), instead of the O(n log n)
+// time and O(n) memory of pushing everything into a heap and popping k.
+type BoundedHeap[T any] struct {
+    worst      *ImplHeap[T] // Reversed heap: the root is the worst kept element
+    k          int
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+    isMaxHeap  bool
+}
+
+// NewBoundedMinHeap creates a BoundedHeap that keeps the k smallest elements.
+func NewBoundedMinHeap[T any](k int, comparator func(a, b T) int) *BoundedHeap[T] {
+    return &BoundedHeap[T]{worst: NewMaxHeap(comparator), k: k, comparator: comparator, isMaxHeap: false}
+}
+
+// NewBoundedMaxHeap creates a BoundedHeap that keeps the k largest elements.
+func NewBoundedMaxHeap[T any](k int, comparator func(a, b T) int) *BoundedHeap[T] {
+    return &BoundedHeap[T]{worst: NewMinHeap(comparator), k: k, comparator: comparator, isMaxHeap: true}
+}
+
+// isBetter return true if value a should be kept over value b
+func (b *BoundedHeap[T]) isBetter(x, y T) bool {
+    cmp := b.comparator(x, y)
+    if b.isMaxHeap {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+// Offer adds value if there is room, or if it is better than the worst kept element, which is
+// evicted. Returns true if value was kept. On ties the element already kept wins.
+//
+// Time complexity: O(log k)
+func (b *BoundedHeap[T]) Offer(value T) bool {
+    if b.k <= 0 {
+        return false
+    }
+    if b.worst.Size() < b.k {
+        b.worst.Push(value)
+        return true
+    }
+    if !b.isBetter(value, b.worst.data[0]) {
+        return false
+    }
+    // Replace the root in place: a single sift-down instead of Pop + Push
+    b.worst.data[0] = value
+    b.worst.siftDown(0)
+    b.worst.modCount++
+    return true
+}
+
+// OfferAll offers every element of seq, for streaming sources.
+func (b *BoundedHeap[T]) OfferAll(seq iter.Seq[T]) {
+    for value := range seq {
+        b.Offer(value)
+    }
+}
+
+// Worst returns the worst kept element: the one the next Offer has to beat once the heap is full.
+func (b *BoundedHeap[T]) Worst() (T, bool) {
+    return b.worst.Peek()
+}
+
+// Result returns the kept elements sorted from best to worst, in a new slice.
+// The heap is not modified, so it can keep receiving elements.
+//
+// Time complexity: O(k log k)
+func (b *BoundedHeap[T]) Result() []T {
+    result := slices.Clone(b.worst.data)
+    slices.SortFunc(result, func(x, y T) int {
+        if b.isMaxHeap {
+            return b.comparator(y, x)
+        }
+        return b.comparator(x, y)
+    })
+    return result
+}
+
+// All returns a sequence over the kept elements, in no particular order.
+func (b *BoundedHeap[T]) All() iter.Seq[T] {
+    return b.worst.All()
+}
+
+// Size returns the number of kept elements, at most k.
+func (b *BoundedHeap[T]) Size() int {
+    return b.worst.Size()
+}
+
+// Capacity returns k, the maximum number of kept elements.
+func (b *BoundedHeap[T]) Capacity() int {
+    return b.k
+}
+
+// IsFull returns true when k elements are kept, and new ones have to beat Worst.
+func (b *BoundedHeap[T]) IsFull() bool {
+    return b.worst.Size() >= b.k
+}
+
+// Clear removes all the kept elements.
+func (b *BoundedHeap[T]) Clear() {
+    b.worst.Clear()
+}
@@ -0,0 +1,76 @@
+package heap
+
+import (
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestBoundedHeap_Smallest tests keeping the k smallest elements
+func TestBoundedHeap_Smallest(t *testing.T) {
+    b := NewBoundedMinHeap(3, compareInt)
+    assert.Equal(t, 3, b.Capacity())
+    assert.False(t, b.IsFull())
+
+    for _, value := range []int{7, 2, 9} {
+        assert.True(t, b.Offer(value), "Offer should keep %d while there is room", value)
+    }
+    assert.True(t, b.IsFull())
+    worst, _ := b.Worst()
+    assert.Equal(t, 9, worst)
+
+    assert.True(t, b.Offer(4), "4 should evict 9")
+    assert.False(t, b.Offer(8), "8 is worse than every kept element")
+    assert.False(t, b.Offer(7), "ties should keep the element already in")
+    assert.True(t, b.Offer(1))
+
+    assert.Equal(t, 3, b.Size())
+    assert.Equal(t, []int{1, 2, 4}, b.Result())
+    assert.ElementsMatch(t, []int{1, 2, 4}, slices.Collect(b.All()))
+}
+
+// TestBoundedHeap_Largest tests keeping the k largest elements
+func TestBoundedHeap_Largest(t *testing.T) {
+    b := NewBoundedMaxHeap(2, compareInt)
+    b.OfferAll(slices.Values([]int{5, 1, 8, 3, 9, 2}))
+
+    worst, _ := b.Worst()
+    assert.Equal(t, 8, worst)
+    assert.Equal(t, []int{9, 8}, b.Result())
+}
+
+// TestBoundedHeap_ResultDoesNotConsume tests that the heap keeps streaming after Result
+func TestBoundedHeap_ResultDoesNotConsume(t *testing.T) {
+    b := NewBoundedMinHeap(2, compareInt)
+    b.OfferAll(slices.Values([]int{4, 3}))
+    assert.Equal(t, []int{3, 4}, b.Result())
+
+    b.Offer(1)
+    assert.Equal(t, []int{1, 3}, b.Result())
+
+    b.Clear()
+    assert.Equal(t, 0, b.Size())
+    _, ok := b.Worst()
+    assert.False(t, ok)
+}
+
+// TestBoundedHeap_ZeroK tests that a non-positive k keeps nothing
+func TestBoundedHeap_ZeroK(t *testing.T) {
+    b := NewBoundedMinHeap(0, compareInt)
+    assert.False(t, b.Offer(1))
+    assert.Empty(t, b.Result())
+    assert.True(t, b.IsFull())
+}
+
+// TestBoundedHeap_Random compares against sorting everything
+func TestBoundedHeap_Random(t *testing.T) {
+    values := randomValues(5, 10000)
+    b := NewBoundedMinHeap(25, compareInt)
+    b.OfferAll(slices.Values(values))
+
+    sorted := slices.Clone(values)
+    slices.Sort(sorted)
+    assert.Equal(t, sorted[:25], b.Result())
+    assert.Equal(t, 25, b.Size(), "memory should be bounded by k")
+}
@@ -0,0 +1,221 @@
+package heap
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
+
+// DaryHeap is an array-backed heap where every node has up to d children instead of 2.
+//
+// Tree structure with indices for d = 3:
+//
+//                   0
+//              /    |    \
+//             1     2     3
+//           / | \  / | \
+//          4  5 6 7  8  9 ...
+//
+// Calculation rules:
+// Parent of node i: (i - 1) / d
+// Children of node i: d*i + 1 ... d*i + d
+//
+// The tree is only log_d(n) levels deep, so Push (sift-up) gets cheaper as d grows, while Pop
+// (sift-down) compares d children per level. Workloads with many more pushes than pops, like
+// Dijkstra on dense graphs, are faster with d = 4 or more; d = 2 behaves like ImplHeap.
+type DaryHeap[T any] struct {
+    data       []T
+    d          int
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+    isMaxHeap  bool
+    modCount   int // Incremented on every modification, for the fail-fast iterator
+}
+
+// NewDaryMinHeap creates and returns a new empty d-ary min-heap. Panics if d is less than 2.
+func NewDaryMinHeap[T any](d int, comparator func(a, b T) int) *DaryHeap[T] {
+    return newDaryHeap(d, comparator, false)
+}
+
+// NewDaryMaxHeap creates and returns a new empty d-ary max-heap. Panics if d is less than 2.
+func NewDaryMaxHeap[T any](d int, comparator func(a, b T) int) *DaryHeap[T] {
+    return newDaryHeap(d, comparator, true)
+}
+
+func newDaryHeap[T any](d int, comparator func(a, b T) int, isMaxHeap bool) *DaryHeap[T] {
+    if d < 2 {
+        panic("heap: d-ary heap needs d >= 2")
+    }
+    return &DaryHeap[T]{data: make([]T, 0), d: d, comparator: comparator, isMaxHeap: isMaxHeap}
+}
+
+// Compile-time check to ensure DaryHeap implements the Heap interface
+var _ Heap[string] = (*DaryHeap[string])(nil)
+
+// isHigherPriority return true if the element on i-th position has higher priority
+// than the element on j-th position
+func (h *DaryHeap[T]) isHigherPriority(i, j int) bool {
+    cmp := h.comparator(h.data[i], h.data[j])
+    if h.isMaxHeap {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+// parent look for the parent of the given node
+func (h *DaryHeap[T]) parent(i int) int {
+    return (i - 1) / h.d
+}
+
+// siftUp moves the given node up the tree while it has higher priority than its parent
+func (h *DaryHeap[T]) siftUp(index int) {
+    for index > 0 && h.isHigherPriority(index, h.parent(index)) {
+        p := h.parent(index)
+        h.data[index], h.data[p] = h.data[p], h.data[index]
+        index = p
+    }
+}
+
+// siftDown moves the given node down the tree, swapping it with its highest priority child
+//
+// O(d log_d n)
+func (h *DaryHeap[T]) siftDown(index int) {
+    n := len(h.data)
+    for {
+        best := index
+        first := h.d*index + 1
+        for child := first; child < first+h.d && child < n; child++ {
+            if h.isHigherPriority(child, best) {
+                best = child
+            }
+        }
+        if best == index {
+            return
+        }
+        h.data[index], h.data[best] = h.data[best], h.data[index]
+        index = best
+    }
+}
+
+// Arity returns d, the maximum number of children of a node.
+func (h *DaryHeap[T]) Arity() int {
+    return h.d
+}
+
+func (h *DaryHeap[T]) Peek() (T, bool) {
+    if len(h.data) == 0 {
+        return *new(T), false
+    }
+    return h.data[0], true
+}
+
+func (h *DaryHeap[T]) Push(value T) {
+    h.modCount++
+    h.data = append(h.data, value)
+    h.siftUp(len(h.data) - 1)
+}
+
+func (h *DaryHeap[T]) Pop() (T, bool) {
+    if len(h.data) == 0 {
+        return *new(T), false
+    }
+
+    h.modCount++
+    last := len(h.data) - 1
+    value := h.data[0]
+    h.data[0] = h.data[last]
+    h.data[last] = *new(T)
+    h.data = h.data[:last]
+    if len(h.data) > 0 {
+        h.siftDown(0)
+    }
+    return value, true
+}
+
+func (h *DaryHeap[T]) Size() int {
+    return len(h.data)
+}
+
+func (h *DaryHeap[T]) IsEmpty() bool {
+    return len(h.data) == 0
+}
+
+func (h *DaryHeap[T]) Clear() {
+    h.modCount++
+    h.data = make([]T, 0)
+}
+
+// Heapify replaces the content of the heap with a copy of elements, in O(n).
+func (h *DaryHeap[T]) Heapify(elements []T) {
+    h.modCount++
+    h.data = make([]T, len(elements))
+    copy(h.data, elements)
+
+    if len(h.data) < 2 {
+        return
+    }
+    // The last parent is the parent of the last element
+    for i := h.parent(len(h.data) - 1); i >= 0; i-- {
+        h.siftDown(i)
+    }
+}
+
+// Merge appends the elements of other and rebuilds the heap in O(n + m), leaving other empty.
+func (h *DaryHeap[T]) Merge(other Heap[T]) {
+    if other == nil || other == Heap[T](h) {
+        return
+    }
+    h.modCount++
+    h.data = append(h.data, other.ToSlice()...)
+    other.Clear()
+
+    if len(h.data) < 2 {
+        return
+    }
+    for i := h.parent(len(h.data) - 1); i >= 0; i-- {
+        h.siftDown(i)
+    }
+}
+
+// ToSlice returns the elements in the underlying array order, in a new slice.
+func (h *DaryHeap[T]) ToSlice() []T {
+    values := make([]T, len(h.data))
+    copy(values, h.data)
+    return values
+}
+
+// Iterator returns an iterator that traverses the elements in level order, without copying.
+// It is fail-fast: once the heap is modified, HasNext and Next panic with an error wrapping
+// errs.ErrConcurrentModification.
+func (h *DaryHeap[T]) Iterator() iterator.Iterator[T] {
+    return newFailFastHeapIterator(h.data, &h.modCount)
+}
+
+// SortedIterator Create an iterator that returns the elements in sorted order. Performs a copy of the data.
+func (h *DaryHeap[T]) SortedIterator() iterator.Iterator[T] {
+    return newHeapSortedIterator[T](h)
+}
+
+// All returns a sequence over the underlying array, in level order.
+func (h *DaryHeap[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, value := range h.data {
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
+
+// Sorted returns a sequence in priority order, popping from a copy of the heap.
+func (h *DaryHeap[T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
+// Clone returns a binary ImplHeap with the same elements and ordering, as required by the Heap
+// interface.
+// Complexity: O(n)
+func (h *DaryHeap[T]) Clone() *ImplHeap[T] {
+    clone := &ImplHeap[T]{comparator: h.comparator, isMaxHeap: h.isMaxHeap}
+    clone.HeapifyInPlace(h.ToSlice())
+    return clone
+}
@@ -0,0 +1,43 @@
+package heap
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestNewDaryHeap tests the creation of a d-ary heap and the validation of d
+func TestNewDaryHeap(t *testing.T) {
+    heap := NewDaryMinHeap(4, compareInt)
+    assert.Equal(t, 4, heap.Arity())
+    assert.False(t, heap.isMaxHeap)
+    assert.True(t, heap.IsEmpty())
+    assert.True(t, NewDaryMaxHeap(3, compareInt).isMaxHeap)
+
+    assert.Panics(t, func() { NewDaryMinHeap(1, compareInt) }, "d below 2 should panic")
+    assert.Panics(t, func() { NewDaryMaxHeap(0, compareInt) }, "d below 2 should panic")
+}
+
+// TestDaryHeap_Layout tests that every node has up to d children in the array
+func TestDaryHeap_Layout(t *testing.T) {
+    heap := NewDaryMinHeap(3, compareInt)
+    heap.Heapify([]int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+
+    // Root 0 has children 1..3, node 1 has children 4..6, node 2 has children 7..9
+    for i := 1; i < heap.Size(); i++ {
+        assert.LessOrEqual(t, heap.data[(i-1)/3], heap.data[i], "parent should not be greater than child %d", i)
+    }
+    assert.Equal(t, 0, heap.data[0])
+}
+
+// TestDaryHeap_HeapifyCopies tests that Heapify does not reorder the caller's slice
+func TestDaryHeap_HeapifyCopies(t *testing.T) {
+    input := []int{5, 4, 3, 2, 1}
+    heap := NewDaryMinHeap(2, compareInt)
+    heap.Heapify(input)
+
+    assert.Equal(t, []int{5, 4, 3, 2, 1}, input)
+    heap.ToSlice()[0] = 100
+    top, _ := heap.Peek()
+    assert.Equal(t, 1, top, "ToSlice should return a copy")
+}
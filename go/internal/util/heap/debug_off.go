@@ -0,0 +1,6 @@
+//go:build !debug
+
+package heap
+
+// debugValidate is off in regular builds: build with -tags debug to turn it on.
+const debugValidate = false
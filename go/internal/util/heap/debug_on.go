@@ -0,0 +1,7 @@
+//go:build debug
+
+package heap
+
+// debugValidate makes ImplHeap validate the heap property after every Push, Pop and Heapify,
+// panicking with an *InvariantError on the first violation.
+const debugValidate = true
@@ -0,0 +1,285 @@
+package heap
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
+
+// fibonacciNode is a node of a FibonacciHeap. Siblings form a circular doubly linked list through
+// left and right, and child points to any node of the children list.
+type fibonacciNode[T any] struct {
+    value  T
+    child  *fibonacciNode[T]
+    left   *fibonacciNode[T]
+    right  *fibonacciNode[T]
+    degree int // Number of children
+}
+
+// FibonacciHeap is a collection of heap-ordered trees, whose roots are kept in a circular list.
+// The heap only points to the root with the highest priority.
+//
+//   top
+//    ↓
+//   (2) ←→ (7) ←→ (5) ←→ back to (2)
+//    |      |
+//   (4)    (9)
+//
+// Push adds a new single node tree to the root list and Meld concatenates two root lists, both
+// in O(1). All the work is postponed to Pop, which removes the top, moves its children to the
+// root list, and then consolidates the roots: trees of the same degree are linked (the one with
+// lower priority becomes a child of the other) until every root has a different degree. Pop is
+// O(log n) amortized.
+//
+// The O(1) amortized DecreaseKey of the textbook version is not part of the Heap interface and is
+// not implemented: use IndexedHeap when priorities change.
+type FibonacciHeap[T any] struct {
+    top        *fibonacciNode[T]
+    size       int
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+    isMaxHeap  bool
+}
+
+// NewFibonacciMinHeap creates and returns a new empty Fibonacci min-heap.
+func NewFibonacciMinHeap[T any](comparator func(a, b T) int) *FibonacciHeap[T] {
+    return &FibonacciHeap[T]{comparator: comparator, isMaxHeap: false}
+}
+
+// NewFibonacciMaxHeap creates and returns a new empty Fibonacci max-heap.
+func NewFibonacciMaxHeap[T any](comparator func(a, b T) int) *FibonacciHeap[T] {
+    return &FibonacciHeap[T]{comparator: comparator, isMaxHeap: true}
+}
+
+// Compile-time check to ensure FibonacciHeap implements the Heap interface
+var _ Heap[string] = (*FibonacciHeap[string])(nil)
+
+// hasHigherPriority return true if value a has higher priority than value b
+func (h *FibonacciHeap[T]) hasHigherPriority(a, b T) bool {
+    cmp := h.comparator(a, b)
+    if h.isMaxHeap {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+// newFibonacciNode creates a node that is a circular list on its own
+func newFibonacciNode[T any](value T) *fibonacciNode[T] {
+    node := &fibonacciNode[T]{value: value}
+    node.left, node.right = node, node
+    return node
+}
+
+// splice joins two circular lists, inserting the list of b right after a
+func splice[T any](a, b *fibonacciNode[T]) {
+    aRight, bLeft := a.right, b.left
+    a.right, b.left = b, a
+    bLeft.right, aRight.left = aRight, bLeft
+}
+
+// addRoots splices a list into the root list, updating top
+func (h *FibonacciHeap[T]) addRoots(list *fibonacciNode[T]) {
+    if h.top == nil {
+        h.top = list
+    } else {
+        splice(h.top, list)
+    }
+    // Only the head of the new list is checked: callers either add a single node or a list
+    // whose top is its head, or consolidate right after
+    if h.hasHigherPriority(list.value, h.top.value) {
+        h.top = list
+    }
+}
+
+// consolidate links the roots until no two have the same degree, and finds the new top.
+// start is any node of the root list.
+func (h *FibonacciHeap[T]) consolidate(start *fibonacciNode[T]) {
+    // Detach the roots first, since the list is rebuilt from scratch
+    var roots []*fibonacciNode[T]
+    for node := start; ; {
+        roots = append(roots, node)
+        node = node.right
+        if node == start {
+            break
+        }
+    }
+
+    // byDegree[d] is the root of degree d found so far
+    var byDegree []*fibonacciNode[T]
+    for _, x := range roots {
+        x.left, x.right = x, x
+        d := x.degree
+        for d < len(byDegree) && byDegree[d] != nil {
+            y := byDegree[d]
+            if h.hasHigherPriority(y.value, x.value) {
+                x, y = y, x
+            }
+            // Link: y becomes a child of x
+            if x.child == nil {
+                x.child = y
+            } else {
+                splice(x.child, y)
+            }
+            x.degree++
+            byDegree[d] = nil
+            d++
+        }
+        for d >= len(byDegree) {
+            byDegree = append(byDegree, nil)
+        }
+        byDegree[d] = x
+    }
+
+    h.top = nil
+    for _, node := range byDegree {
+        if node != nil {
+            h.addRoots(node)
+        }
+    }
+}
+
+// Meld moves all elements of other into this heap, in O(1), leaving other empty.
+// Both heaps are expected to share the same ordering; this heap's comparator is used.
+func (h *FibonacciHeap[T]) Meld(other *FibonacciHeap[T]) {
+    if other == nil || other == h || other.top == nil {
+        return
+    }
+    h.addRoots(other.top)
+    h.size += other.size
+    other.top = nil
+    other.size = 0
+}
+
+// Merge moves the elements of other into this heap, leaving other empty. Another FibonacciHeap with
+// the same orientation is melded in O(1); any other heap is drained with O(1) pushes, in O(m).
+func (h *FibonacciHeap[T]) Merge(other Heap[T]) {
+    if other == nil || other == Heap[T](h) {
+        return
+    }
+    if same, ok := other.(*FibonacciHeap[T]); ok && same.isMaxHeap == h.isMaxHeap {
+        h.Meld(same)
+        return
+    }
+    for _, value := range other.ToSlice() {
+        h.Push(value)
+    }
+    other.Clear()
+}
+
+func (h *FibonacciHeap[T]) Peek() (T, bool) {
+    if h.top == nil {
+        return *new(T), false
+    }
+    return h.top.value, true
+}
+
+// Push inserts value in O(1).
+func (h *FibonacciHeap[T]) Push(value T) {
+    h.addRoots(newFibonacciNode(value))
+    h.size++
+}
+
+// Pop removes the top in O(log n) amortized.
+func (h *FibonacciHeap[T]) Pop() (T, bool) {
+    if h.top == nil {
+        return *new(T), false
+    }
+    top := h.top
+
+    // Promote the children to roots
+    if top.child != nil {
+        splice(top, top.child)
+        top.child = nil
+    }
+
+    h.size--
+    if top.right == top {
+        h.top = nil
+    } else {
+        // Unlink the old top, and consolidate from its neighbour
+        top.left.right = top.right
+        top.right.left = top.left
+        h.consolidate(top.right)
+    }
+    top.left, top.right = nil, nil
+    return top.value, true
+}
+
+func (h *FibonacciHeap[T]) Size() int {
+    return h.size
+}
+
+func (h *FibonacciHeap[T]) IsEmpty() bool {
+    return h.size == 0
+}
+
+func (h *FibonacciHeap[T]) Clear() {
+    h.top = nil
+    h.size = 0
+}
+
+// Heapify replaces the content of the heap with elements. With O(1) Push this is O(n).
+func (h *FibonacciHeap[T]) Heapify(elements []T) {
+    h.Clear()
+    for _, value := range elements {
+        h.Push(value)
+    }
+}
+
+// ToSlice returns the elements in pre-order of the trees, starting from the top, in a new slice.
+func (h *FibonacciHeap[T]) ToSlice() []T {
+    values := make([]T, 0, h.size)
+    for value := range h.All() {
+        values = append(values, value)
+    }
+    return values
+}
+
+// Iterator returns an iterator over a snapshot of the elements, in pre-order of the trees.
+func (h *FibonacciHeap[T]) Iterator() iterator.Iterator[T] {
+    return newHeapIterator(h.ToSlice())
+}
+
+// SortedIterator Create an iterator that returns the elements in sorted order. Performs a copy of the data.
+func (h *FibonacciHeap[T]) SortedIterator() iterator.Iterator[T] {
+    return newHeapSortedIterator[T](h)
+}
+
+// All returns a sequence over the elements in pre-order of the trees, starting from the top.
+func (h *FibonacciHeap[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if h.top != nil {
+            walkFibonacci(h.top, yield)
+        }
+    }
+}
+
+// walkFibonacci yields every node of the circular list starting at start, each followed by its
+// subtree. The recursion depth is bounded by the degree, which is O(log n).
+func walkFibonacci[T any](start *fibonacciNode[T], yield func(T) bool) bool {
+    for node := start; ; {
+        if !yield(node.value) {
+            return false
+        }
+        if node.child != nil && !walkFibonacci(node.child, yield) {
+            return false
+        }
+        node = node.right
+        if node == start {
+            return true
+        }
+    }
+}
+
+// Sorted returns a sequence in priority order, popping from a copy of the heap.
+func (h *FibonacciHeap[T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
+// Clone returns an ImplHeap with the same elements and ordering, as required by the Heap
+// interface.
+// Complexity: O(n)
+func (h *FibonacciHeap[T]) Clone() *ImplHeap[T] {
+    clone := &ImplHeap[T]{comparator: h.comparator, isMaxHeap: h.isMaxHeap}
+    clone.HeapifyInPlace(h.ToSlice())
+    return clone
+}
@@ -0,0 +1,65 @@
+package heap
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestFibonacciHeap_Meld tests that Meld moves all elements and empties the other heap
+func TestFibonacciHeap_Meld(t *testing.T) {
+    a := NewFibonacciMinHeap(compareInt)
+    a.Heapify([]int{5, 1, 9})
+    b := NewFibonacciMinHeap(compareInt)
+    b.Heapify([]int{4, 0, 7, 3})
+
+    a.Meld(b)
+    assert.Equal(t, 7, a.Size())
+    assert.True(t, b.IsEmpty())
+
+    top, _ := a.Peek()
+    assert.Equal(t, 0, top, "Meld should update the top")
+    assert.Equal(t, []int{0, 1, 3, 4, 5, 7, 9}, popAll(a))
+}
+
+// TestFibonacciHeap_MeldEdgeCases tests melding empty heaps, nil and the heap itself
+func TestFibonacciHeap_MeldEdgeCases(t *testing.T) {
+    a := NewFibonacciMaxHeap(compareInt)
+    a.Meld(NewFibonacciMaxHeap(compareInt))
+    assert.True(t, a.IsEmpty())
+
+    b := NewFibonacciMaxHeap(compareInt)
+    b.Push(3)
+    a.Meld(b)
+    a.Meld(nil)
+    a.Meld(a)
+    assert.Equal(t, 1, a.Size())
+
+    b.Push(10)
+    a.Meld(b)
+    assert.Equal(t, []int{10, 3}, popAll(a))
+}
+
+// TestFibonacciHeap_Consolidate tests that after a Pop no two roots share a degree
+func TestFibonacciHeap_Consolidate(t *testing.T) {
+    heap := NewFibonacciMinHeap(compareInt)
+    for i := 0; i < 100; i++ {
+        heap.Push(i)
+    }
+    heap.Pop()
+
+    degrees := make(map[int]bool)
+    roots := 0
+    for node := heap.top; ; {
+        assert.False(t, degrees[node.degree], "two roots with degree %d", node.degree)
+        degrees[node.degree] = true
+        roots++
+        node = node.right
+        if node == heap.top {
+            break
+        }
+    }
+    // 99 elements, binary representation 1100011: one tree per bit set
+    assert.Equal(t, 4, roots)
+    assert.Equal(t, 1, heap.top.value)
+}
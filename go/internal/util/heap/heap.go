@@ -1,6 +1,10 @@
 package heap
 
-import "interview_go/internal/util/iterator"
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
 
 // Heap is a generic interface representing a heap data structure.
 // A heap is a specialized tree-based structure that satisfies the heap property:
@@ -94,7 +98,8 @@ type Heap[T any] interface {
     // Time complexity: O(1)
     Clear()
 
-    // Heapify converts a slice of elements into a valid heap structure.
+    // Heapify converts a slice of elements into a valid heap structure, replacing the content
+    // of the heap. The heap keeps its own copy: elements is neither modified nor retained.
     // This is more efficient than inserting elements one by one.
     //
     // Example: Heapify [20, 15, 10, 17] into a min-heap:
@@ -111,7 +116,7 @@ type Heap[T any] interface {
     // Time complexity: O(n) - more efficient than n × Push which would be O(n log n)
     Heapify(elements []T)
 
-    // ToSlice returns a slice containing all elements in the heap.
+    // ToSlice returns a new slice containing all elements in the heap, which the caller owns.
     // The order may be the internal array representation (not necessarily sorted).
     // For a sorted output, repeatedly call Pop() instead.
     //
@@ -132,6 +137,10 @@ type Heap[T any] interface {
     // Iterator order: 10, 15, 20, 17, 25
     //
     // For sorted order, use Pop() repeatedly instead.
+    //
+    // The iterator never returns stale data: array-backed heaps iterate their array in place and
+    // panic with an error wrapping errs.ErrConcurrentModification once the heap is modified,
+    // node-based heaps iterate over a snapshot.
     Iterator() iterator.Iterator[T]
 
     // SortedIterator returns an iterator that traverses elements in sorted order.
@@ -144,6 +153,35 @@ type Heap[T any] interface {
     // Space complexity: O(n) for the heap copy
     SortedIterator() iterator.Iterator[T]
 
+    // All returns a sequence over the elements in the same (level) order as Iterator, for use with range.
+    All() iter.Seq[T]
+
+    // Sorted returns a sequence over the elements in the same order as SortedIterator.
+    // Like SortedIterator, it works on a copy and leaves the heap untouched.
+    Sorted() iter.Seq[T]
+
+    // Merge moves all the elements of other into this heap, leaving other empty.
+    // Both heaps are expected to share the same ordering; this heap's comparator is used.
+    // Merging a heap with itself does nothing.
+    //
+    // Example: Merging [3, 8] into the min-heap [1, 5]:
+    //
+    // Before:
+    //      1        3
+    //     /        /
+    //    5        8
+    //
+    // After (other is empty):
+    //        1
+    //       / \
+    //      3   5
+    //     /
+    //    8
+    //
+    // Time complexity: O(n + m) for array-backed heaps, which rebuild with Heapify;
+    // O(1) for PairingHeap and FibonacciHeap when other has the same type.
+    Merge(other Heap[T])
+
     // Clone returns a copy of the heap.
     Clone() *ImplHeap[T]
 }
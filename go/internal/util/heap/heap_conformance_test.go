@@ -0,0 +1,268 @@
+package heap
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// Conformance suite: every Heap implementation must pass the same tests.
+// Add new implementations to heapImplementations.
+
+func compareInt(a, b int) int {
+    return a - b
+}
+
+// lastKey is shared by every IndexedHeap of the suite, so keys never collide, even across Merge
+var lastKey int
+
+// uniqueKey numbers every value pushed into an IndexedHeap, so that equal values get distinct
+// keys and are kept as duplicates, like in the other heaps
+func uniqueKey(int) int {
+    lastKey++
+    return lastKey
+}
+
+var heapImplementations = []struct {
+    name    string
+    minHeap func() Heap[int]
+    maxHeap func() Heap[int]
+}{
+    {"ImplHeap", func() Heap[int] { return NewMinHeap(compareInt) }, func() Heap[int] { return NewMaxHeap(compareInt) }},
+    {"DaryHeap(2)", func() Heap[int] { return NewDaryMinHeap(2, compareInt) }, func() Heap[int] { return NewDaryMaxHeap(2, compareInt) }},
+    {"DaryHeap(3)", func() Heap[int] { return NewDaryMinHeap(3, compareInt) }, func() Heap[int] { return NewDaryMaxHeap(3, compareInt) }},
+    {"DaryHeap(8)", func() Heap[int] { return NewDaryMinHeap(8, compareInt) }, func() Heap[int] { return NewDaryMaxHeap(8, compareInt) }},
+    {"PairingHeap", func() Heap[int] { return NewPairingMinHeap(compareInt) }, func() Heap[int] { return NewPairingMaxHeap(compareInt) }},
+    {"FibonacciHeap", func() Heap[int] { return NewFibonacciMinHeap(compareInt) }, func() Heap[int] { return NewFibonacciMaxHeap(compareInt) }},
+    {"IndexedHeap", func() Heap[int] { return NewIndexedMinHeap(uniqueKey, compareInt) }, func() Heap[int] { return NewIndexedMaxHeap(uniqueKey, compareInt) }},
+}
+
+// randomValues returns n values with plenty of duplicates
+func randomValues(seed int64, n int) []int {
+    rnd := rand.New(rand.NewSource(seed))
+    values := make([]int, n)
+    for i := range values {
+        values[i] = rnd.Intn(n / 2)
+    }
+    return values
+}
+
+// popAll empties the heap, returning the elements in pop order
+func popAll(h Heap[int]) []int {
+    var values []int
+    for !h.IsEmpty() {
+        value, _ := h.Pop()
+        values = append(values, value)
+    }
+    return values
+}
+
+// TestConformance_Empty tests the behavior of an empty heap
+func TestConformance_Empty(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            assert.True(t, h.IsEmpty())
+            assert.Equal(t, 0, h.Size())
+
+            _, ok := h.Peek()
+            assert.False(t, ok, "Peek on empty heap should return false")
+            _, ok = h.Pop()
+            assert.False(t, ok, "Pop on empty heap should return false")
+
+            assert.Empty(t, h.ToSlice())
+            assert.False(t, h.Iterator().HasNext())
+            assert.False(t, h.SortedIterator().HasNext())
+            assert.Empty(t, slices.Collect(h.All()))
+        })
+    }
+}
+
+// TestConformance_PushPop tests that Pop returns the elements in priority order
+func TestConformance_PushPop(t *testing.T) {
+    values := randomValues(1, 500)
+    ascending := slices.Clone(values)
+    slices.Sort(ascending)
+    descending := slices.Clone(ascending)
+    slices.Reverse(descending)
+
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            minHeap, maxHeap := impl.minHeap(), impl.maxHeap()
+            for _, value := range values {
+                minHeap.Push(value)
+                maxHeap.Push(value)
+            }
+            assert.Equal(t, len(values), minHeap.Size())
+
+            top, ok := minHeap.Peek()
+            assert.True(t, ok)
+            assert.Equal(t, ascending[0], top)
+            top, _ = maxHeap.Peek()
+            assert.Equal(t, descending[0], top)
+
+            assert.Equal(t, ascending, popAll(minHeap))
+            assert.Equal(t, descending, popAll(maxHeap))
+            assert.True(t, minHeap.IsEmpty())
+        })
+    }
+}
+
+// TestConformance_Interleaved tests mixing pushes and pops against a sorted reference
+func TestConformance_Interleaved(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            rnd := rand.New(rand.NewSource(7))
+            h := impl.minHeap()
+            var reference []int
+
+            for i := 0; i < 3000; i++ {
+                if rnd.Intn(3) > 0 || len(reference) == 0 {
+                    value := rnd.Intn(1000)
+                    h.Push(value)
+                    reference = append(reference, value)
+                    slices.Sort(reference)
+                } else {
+                    value, ok := h.Pop()
+                    assert.True(t, ok)
+                    assert.Equal(t, reference[0], value)
+                    reference = reference[1:]
+                }
+                assert.Equal(t, len(reference), h.Size())
+            }
+            assert.Equal(t, reference, popAll(h))
+        })
+    }
+}
+
+// TestConformance_Heapify tests building from a slice, replacing the previous content
+func TestConformance_Heapify(t *testing.T) {
+    values := randomValues(2, 200)
+    expected := slices.Clone(values)
+    slices.Sort(expected)
+
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            h.Push(-1)
+            h.Heapify(slices.Clone(values))
+            assert.Equal(t, len(values), h.Size(), "Heapify should replace the content")
+            assert.Equal(t, expected, popAll(h))
+
+            h.Heapify(nil)
+            assert.True(t, h.IsEmpty())
+        })
+    }
+}
+
+// TestConformance_Clear tests that the heap is reusable after Clear
+func TestConformance_Clear(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            h.Heapify([]int{3, 1, 2})
+            h.Clear()
+            assert.True(t, h.IsEmpty())
+            assert.Equal(t, 0, h.Size())
+
+            h.Push(5)
+            top, _ := h.Peek()
+            assert.Equal(t, 5, top)
+        })
+    }
+}
+
+// TestConformance_Traversal tests that ToSlice, Iterator and All visit every element once,
+// starting from the root, without changing the heap
+func TestConformance_Traversal(t *testing.T) {
+    values := randomValues(3, 100)
+
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            for _, value := range values {
+                h.Push(value)
+            }
+            // Node-based heaps keep their structure between pops, so traverse a non trivial one
+            popped, _ := h.Pop()
+            h.Push(popped)
+
+            slice := h.ToSlice()
+            assert.ElementsMatch(t, values, slice)
+            top, _ := h.Peek()
+            assert.Equal(t, top, slice[0], "traversal should start at the root")
+
+            assert.Equal(t, slice, slices.Collect(h.All()))
+            var iterated []int
+            for it := h.Iterator(); it.HasNext(); {
+                iterated = append(iterated, it.Next())
+            }
+            assert.Equal(t, slice, iterated)
+            assert.Equal(t, len(values), h.Size(), "traversal should not modify the heap")
+        })
+    }
+}
+
+// TestConformance_Sorted tests SortedIterator and Sorted work on a copy
+func TestConformance_Sorted(t *testing.T) {
+    values := randomValues(4, 100)
+    expected := slices.Clone(values)
+    slices.Sort(expected)
+
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            h.Heapify(slices.Clone(values))
+
+            var sorted []int
+            for it := h.SortedIterator(); it.HasNext(); {
+                sorted = append(sorted, it.Next())
+            }
+            assert.Equal(t, expected, sorted)
+            assert.Equal(t, expected, slices.Collect(h.Sorted()))
+            assert.Equal(t, len(values), h.Size(), "sorted traversal should not modify the heap")
+
+            it := h.SortedIterator()
+            for it.HasNext() {
+                it.Next()
+            }
+            assert.Panics(t, func() { it.Next() }, "Next should panic after iteration completes")
+        })
+    }
+}
+
+// TestConformance_Clone tests that the clone has the same ordering and is independent
+func TestConformance_Clone(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.maxHeap()
+            h.Heapify([]int{4, 9, 1, 7})
+
+            clone := h.Clone()
+            h.Push(100)
+            clone.Push(0)
+
+            assert.Equal(t, []int{9, 7, 4, 1, 0}, popAll(clone))
+            assert.Equal(t, []int{100, 9, 7, 4, 1}, popAll(h))
+        })
+    }
+}
+
+// TestConformance_Ownership tests that Heapify and ToSlice never share memory with the caller
+func TestConformance_Ownership(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.minHeap()
+            elements := []int{4, 3, 2, 1}
+            h.Heapify(elements)
+            assert.Equal(t, []int{4, 3, 2, 1}, elements, "Heapify should not reorder the caller's slice")
+
+            elements[0] = -1
+            slice := h.ToSlice()
+            slice[0] = -2
+            assert.Equal(t, []int{1, 2, 3, 4}, popAll(h))
+        })
+    }
+}
@@ -1,6 +1,8 @@
 package heap
 
 import (
+    "iter"
+
     "interview_go/internal/util/iterator"
 )
 
@@ -48,10 +50,15 @@ import (
 //   - Parent: (3 - 1) / 2 = 1
 //   - Left child: 2*3 + 1 = 7
 //   - Right child: 2*3 + 2 = 8
+//
+// Ownership: the heap owns its array. Heapify copies the caller's slice and ToSlice returns a copy,
+// so neither side can corrupt the other. HeapifyInPlace and Unsafe skip the copy for hot paths,
+// sharing the array with the caller under the rules documented on each.
 type ImplHeap[T any] struct {
     data       []T
     comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
     isMaxHeap  bool
+    modCount   int // Incremented on every modification, for the fail-fast iterators
 }
 
 // NewMinHeap creates and returns a new empty heap.
@@ -155,11 +162,14 @@ func (h *ImplHeap[T]) Peek() (T, bool) {
 }
 
 func (h *ImplHeap[T]) Push(value T) {
+    h.modCount++
+
     // Add the element to the end of the array
     h.data = append(h.data, value)
 
     // Sift up the new element to the correct position
     h.siftUp(len(h.data) - 1)
+    h.checkInvariant()
 }
 
 func (h *ImplHeap[T]) Pop() (T, bool) {
@@ -171,6 +181,7 @@ func (h *ImplHeap[T]) Pop() (T, bool) {
         return zeroValue, false
     }
 
+    h.modCount++
     size := len(h.data)
 
     // Store the value to be returned (the minimum/root element)
@@ -181,6 +192,8 @@ func (h *ImplHeap[T]) Pop() (T, bool) {
     h.data[0] = h.data[size-1]
 
     // 5. Shrink the Slice (remove the last element, which has been moved to index 0).
+    // The vacated slot is cleared, so the garbage collector can reclaim pointers.
+    h.data[size-1] = zeroValue
     h.data = h.data[:size-1]
 
     // Restore the Heap Property (Sift-Down)
@@ -188,6 +201,7 @@ func (h *ImplHeap[T]) Pop() (T, bool) {
     if len(h.data) > 0 {
         h.siftDown(0)
     }
+    h.checkInvariant()
 
     return minValue, true
 }
@@ -201,27 +215,67 @@ func (h *ImplHeap[T]) IsEmpty() bool {
 }
 
 func (h *ImplHeap[T]) Clear() {
+    h.modCount++
     h.data = make([]T, 0)
 }
 
+// Heapify replaces the content of the heap with a copy of elements, which is left untouched.
 func (h *ImplHeap[T]) Heapify(elements []T) {
-    // Copy data
+    data := make([]T, len(elements))
+    copy(data, elements)
+    h.HeapifyInPlace(data)
+}
+
+// HeapifyInPlace replaces the content of the heap with elements, without copying: elements is
+// reordered and becomes the heap's array. The caller hands it over, and must not read or modify
+// it afterwards.
+//
+// Time complexity: O(n), with no allocation
+func (h *ImplHeap[T]) HeapifyInPlace(elements []T) {
+    h.modCount++
     h.data = elements
 
     // Begin sifting down
     for i := len(h.data)/2 - 1; i >= 0; i-- {
         h.siftDown(i)
     }
+    h.checkInvariant()
+}
+
+// Merge appends the elements of other and rebuilds the heap in O(n + m), leaving other empty.
+func (h *ImplHeap[T]) Merge(other Heap[T]) {
+    if other == nil || other == Heap[T](h) {
+        return
+    }
+    h.modCount++
+    h.data = append(h.data, other.ToSlice()...)
+    other.Clear()
+
+    for i := len(h.data)/2 - 1; i >= 0; i-- {
+        h.siftDown(i)
+    }
+    h.checkInvariant()
 }
 
 // ToSlice returns a copy of the underlying data slice - similar to BFS
 func (h *ImplHeap[T]) ToSlice() []T {
+    data := make([]T, len(h.data))
+    copy(data, h.data)
+    return data
+}
+
+// Unsafe returns the underlying array itself, in level order, without copying.
+// The view is read-only: writing to it can break the heap property. It is only valid until the
+// next modification of the heap, which may reorder or reallocate the array.
+func (h *ImplHeap[T]) Unsafe() []T {
     return h.data
 }
 
-// Iterator returns an iterator that traverses the elements in the heap.
+// Iterator returns an iterator that traverses the elements in the heap, in level order.
+// It reads the underlying array without copying, and is fail-fast: once the heap is modified,
+// HasNext and Next panic with an error wrapping errs.ErrConcurrentModification.
 func (h *ImplHeap[T]) Iterator() iterator.Iterator[T] {
-    return newHeapIterator(h.data)
+    return newFailFastHeapIterator(h.data, &h.modCount)
 }
 
 // SortedIterator Create an iterator that returns the elements in sorted order. Performs a copy of the data.
@@ -229,6 +283,27 @@ func (h *ImplHeap[T]) SortedIterator() iterator.Iterator[T] {
     return newHeapSortedIterator(h)
 }
 
+// All returns a sequence over the underlying array, in level order.
+// Like Iterator, it panics if the heap is modified during the loop.
+func (h *ImplHeap[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        expectedModCount := h.modCount
+        for _, value := range h.data {
+            if !yield(value) {
+                return
+            }
+            if h.modCount != expectedModCount {
+                panic(errConcurrentModification)
+            }
+        }
+    }
+}
+
+// Sorted returns a sequence in priority order, popping from a copy of the heap.
+func (h *ImplHeap[T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
 // Clone Copies the original Heap and returns a new Heap with the copied data.
 // Complexity: O(n) for slice copy
 func (h *ImplHeap[T]) Clone() *ImplHeap[T] {
@@ -0,0 +1,131 @@
+package heap
+
+import (
+    "fmt"
+    "strings"
+    "unicode/utf8"
+
+    "interview_go/internal/util/errs"
+)
+
+// InvariantError reports an element with higher priority than its parent, found by Validate.
+// It wraps errs.ErrInvariant.
+type InvariantError struct {
+    Parent int // Index of the parent in the underlying array
+    Child  int // Index of the offending child
+}
+
+func (e *InvariantError) Error() string {
+    return fmt.Sprintf("heap: element at index %d has higher priority than its parent at index %d", e.Child, e.Parent)
+}
+
+func (e *InvariantError) Unwrap() error {
+    return errs.ErrInvariant
+}
+
+// Validate checks the heap property for every parent/child pair, returning an *InvariantError
+// for the first child (in array order) with a higher priority than its parent, or nil.
+//
+// A valid heap only stays valid if the comparator is consistent and the elements are not
+// mutated in place while in the heap. Build with the debug tag to validate after every
+// Push, Pop and Heapify:
+//
+//   go test -tags debug ./...
+//
+// Time complexity: O(n)
+func (h *ImplHeap[T]) Validate() error {
+    for child := 1; child < len(h.data); child++ {
+        if h.isHigherPriority(child, parent(child)) {
+            return &InvariantError{Parent: parent(child), Child: child}
+        }
+    }
+    return nil
+}
+
+// checkInvariant panics if the heap is invalid, when built with the debug tag
+func (h *ImplHeap[T]) checkInvariant() {
+    if !debugValidate {
+        return
+    }
+    if err := h.Validate(); err != nil {
+        panic(err)
+    }
+}
+
+// Render draws the heap as a tree, like the diagrams in heap.go:
+//
+//        10
+//       /   \
+//     15    20
+//     / \   /
+//   17 25 30
+//
+// Every element gets the same width, so large heaps render very wide: meant for debugging
+// small ones.
+func (h *ImplHeap[T]) Render() string {
+    if len(h.data) == 0 {
+        return "(empty)"
+    }
+
+    labels := make([]string, len(h.data))
+    cell := 1
+    for i, value := range h.data {
+        labels[i] = fmt.Sprint(value)
+        cell = max(cell, utf8.RuneCountInString(labels[i]))
+    }
+
+    // The last level has room for 2^(levels-1) cells, separated by a space
+    levels := 0
+    for n := len(h.data); n > 0; n >>= 1 {
+        levels++
+    }
+    width := (1 << (levels - 1)) * (cell + 1)
+
+    // center of the i-th node of the tree, in columns
+    center := func(i int) int {
+        level := 0
+        for n := i + 1; n > 1; n >>= 1 {
+            level++
+        }
+        segment := width >> level
+        position := i - (1<<level - 1)
+        return position*segment + segment/2
+    }
+
+    var sb strings.Builder
+    for level, first := 0, 0; first < len(h.data); level, first = level+1, 2*first+1 {
+        last := min(2*first+1, len(h.data))
+
+        values := []rune(strings.Repeat(" ", width+cell))
+        for i := first; i < last; i++ {
+            start := center(i) - utf8.RuneCountInString(labels[i])/2
+            for j, r := range []rune(labels[i]) {
+                values[start+j] = r
+            }
+        }
+        sb.WriteString(strings.TrimRight(string(values), " "))
+        sb.WriteByte('\n')
+
+        if 2*first+1 >= len(h.data) {
+            break
+        }
+        // Edges to the children, half way between the parent and each child
+        edges := []rune(strings.Repeat(" ", width+cell))
+        for i := first; i < last; i++ {
+            if left := leftChild(i); left < len(h.data) {
+                edges[(center(i)+center(left))/2] = '/'
+            }
+            if right := rightChild(i); right < len(h.data) {
+                edges[(center(i)+center(right)+1)/2] = '\\'
+            }
+        }
+        sb.WriteString(strings.TrimRight(string(edges), " "))
+        sb.WriteByte('\n')
+    }
+    return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// String renders the heap as a tree, see Render.
+func (h *ImplHeap[T]) String() string {
+    return h.Render()
+}
@@ -0,0 +1,70 @@
+//go:build debug
+
+package heap
+
+import (
+    "errors"
+    "testing"
+
+    "interview_go/internal/util/errs"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestDebug_BrokenComparator tests that, with the debug tag, an inconsistent comparator is caught
+// on the operation that corrupts the heap
+func TestDebug_BrokenComparator(t *testing.T) {
+    calls := 0
+    // Answers "less" and "greater" alternately, whatever the values
+    broken := func(a, b int) int {
+        calls++
+        if calls%2 == 0 {
+            return -1
+        }
+        return 1
+    }
+    heap := NewMinHeap(broken)
+
+    defer func() {
+        err, ok := recover().(error)
+        assert.True(t, ok, "a corrupted heap should panic with an error")
+        assert.True(t, errors.Is(err, errs.ErrInvariant))
+    }()
+    for i := 0; i < 100; i++ {
+        heap.Push(i)
+        heap.Pop()
+        heap.Push(i)
+    }
+    t.Fatal("the broken comparator was not detected")
+}
+
+// TestDebug_MergeValidates tests that Merge, which rebuilds the whole heap, is checked too
+func TestDebug_MergeValidates(t *testing.T) {
+    broken, calls := false, 0
+    // Consistent until broken is set, then answers "less" and "greater" alternately
+    comparator := func(a, b int) int {
+        if !broken {
+            return compareInt(a, b)
+        }
+        calls++
+        if calls%2 == 0 {
+            return -1
+        }
+        return 1
+    }
+    heap := NewMinHeap(comparator)
+    other := NewMinHeap(compareInt)
+    for i := 0; i < 100; i++ {
+        heap.Push(i)
+        other.Push(-i)
+    }
+
+    defer func() {
+        err, ok := recover().(error)
+        assert.True(t, ok, "a corrupted heap should panic with an error")
+        assert.True(t, errors.Is(err, errs.ErrInvariant))
+    }()
+    broken = true
+    heap.Merge(other)
+    t.Fatal("the broken comparator was not detected")
+}
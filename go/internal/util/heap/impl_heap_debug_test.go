@@ -0,0 +1,74 @@
+package heap
+
+import (
+    "errors"
+    "strings"
+    "testing"
+
+    "interview_go/internal/util/errs"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestValidate tests a valid heap and a corrupted one
+func TestValidate(t *testing.T) {
+    heap := NewMinHeap(compareInt)
+    assert.NoError(t, heap.Validate(), "an empty heap should be valid")
+
+    heap.Heapify([]int{10, 15, 20, 17, 25, 30})
+    assert.NoError(t, heap.Validate())
+
+    // Corrupt a leaf: 30 at index 5 becomes smaller than its parent 20 at index 2
+    heap.data[5] = 1
+    err := heap.Validate()
+    assert.Error(t, err)
+    assert.True(t, errors.Is(err, errs.ErrInvariant))
+
+    var invariant *InvariantError
+    assert.True(t, errors.As(err, &invariant))
+    assert.Equal(t, 2, invariant.Parent)
+    assert.Equal(t, 5, invariant.Child)
+    assert.Contains(t, err.Error(), "index 5")
+}
+
+// TestValidate_MaxHeap tests that the check follows the heap orientation
+func TestValidate_MaxHeap(t *testing.T) {
+    heap := NewMaxHeap(compareInt)
+    heap.Heapify([]int{1, 2, 3})
+    assert.NoError(t, heap.Validate())
+
+    heap.data[0] = 0
+    var invariant *InvariantError
+    assert.True(t, errors.As(heap.Validate(), &invariant))
+    assert.Equal(t, 0, invariant.Parent)
+    assert.Equal(t, 1, invariant.Child)
+}
+
+// TestRender tests the ASCII tree drawing
+func TestRender(t *testing.T) {
+    heap := NewMinHeap(compareInt)
+    assert.Equal(t, "(empty)", heap.Render())
+
+    heap.Heapify([]int{10, 15, 20, 17, 25, 30})
+    expected := strings.Join([]string{
+        "     10",
+        "    /   \\",
+        "  15    20",
+        "  / \\   /",
+        "17 25 30",
+    }, "\n")
+    assert.Equal(t, expected, heap.Render())
+    assert.Equal(t, expected, heap.String())
+}
+
+// TestRender_Shape tests that every level and value is drawn
+func TestRender_Shape(t *testing.T) {
+    heap := NewMinHeap(compareInt)
+    heap.Heapify([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 100})
+
+    lines := strings.Split(heap.Render(), "\n")
+    // 4 levels, and 3 lines of edges between them
+    assert.Len(t, lines, 7)
+    assert.Equal(t, "1", strings.TrimSpace(lines[0]))
+    assert.Equal(t, []string{"8", "9", "100"}, strings.Fields(lines[6]))
+}
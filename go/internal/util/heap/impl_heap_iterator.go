@@ -1,9 +1,18 @@
 package heap
 
 import (
+    "fmt"
+
+    "interview_go/internal/util/errs"
     "interview_go/internal/util/iterator"
 )
 
+// errExhausted is the panic value for calling Next() after the iteration finished
+var errExhausted = fmt.Errorf("heap: %w", errs.ErrExhausted)
+
+// errConcurrentModification is the panic value for using an iterator after its heap changed
+var errConcurrentModification = fmt.Errorf("heap: iterator: %w", errs.ErrConcurrentModification)
+
 // heapIterator[T] holds the state for sequential traversal of the underlying array.
 // It iterates through the 'data' slice in linear (index) order.
 type heapIterator[T any] struct {
@@ -11,30 +20,56 @@ type heapIterator[T any] struct {
     data []T
     // Current index in the slice
     index int
+    // Modification counter of the heap sharing data, nil for a snapshot
+    modCount         *int
+    expectedModCount int
 }
 
-// newHeapIterator is the internal constructor for the iterator.
+// newHeapIterator is the internal constructor for an iterator over a snapshot, a slice that
+// nothing else modifies.
 func newHeapIterator[T any](data []T) iterator.Iterator[T] {
     return &heapIterator[T]{
-        // The iterator takes a COPY of the slice header.
-        // NOTE: This copy still points to the same underlying array data.
         data:  data,
         index: 0,
     }
 }
 
+// newFailFastHeapIterator is the internal constructor for an iterator sharing the array of a heap.
+// The iterator is fail-fast: once the heap is modified (the counter moves), the next call panics
+// with an error wrapping errs.ErrConcurrentModification, instead of returning stale data.
+func newFailFastHeapIterator[T any](data []T, modCount *int) iterator.Iterator[T] {
+    return &heapIterator[T]{
+        // The iterator takes a COPY of the slice header.
+        // NOTE: This copy still points to the same underlying array data.
+        data:             data,
+        index:            0,
+        modCount:         modCount,
+        expectedModCount: *modCount,
+    }
+}
+
+// checkModification panics if the heap changed behind the iterator's back
+func (it *heapIterator[T]) checkModification() {
+    if it.modCount != nil && *it.modCount != it.expectedModCount {
+        panic(errConcurrentModification)
+    }
+}
+
 // HasNext implements the Iterator[T].HasNext method.
 // Checks if the current index is within the bounds of the data slice.
 func (it *heapIterator[T]) HasNext() bool {
+    it.checkModification()
     return it.index < len(it.data)
 }
 
 // Next implements the Iterator[T].Next method.
 // Returns the element at the current index and advances the index.
 func (it *heapIterator[T]) Next() T {
+    it.checkModification()
+
     // Check for panic condition (calling Next() after HasNext() is false)
     if it.index >= len(it.data) {
-        panic("iterator: cannot call Next() after iteration finished")
+        panic(errExhausted)
     }
 
     // 1. Get the current value
@@ -66,7 +101,7 @@ func newHeapSortedIterator[T any](heap Heap[T]) iterator.Iterator[T] {
 func (h heapSortedIterator[T]) Next() T {
     var value, ok = h.heap.Pop()
     if !ok {
-        panic("iterator: cannot call Next() after iteration finished")
+        panic(errExhausted)
     }
     return value
 }
@@ -1,9 +1,13 @@
 package heap
 
 import (
+    "slices"
     "strings"
     "testing"
 
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/iterator"
+
     "github.com/stretchr/testify/assert"
 )
 
@@ -186,3 +190,100 @@ func TestHeap_SortedIteratorDoesNotModifyOriginal(t *testing.T) {
     }
     assert.Equal(t, originalSize, poppedCount, "Original heap should still contain all elements")
 }
+
+// TestHeap_All tests ranging over the heap in level order
+func TestHeap_All(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+    heap.Heapify([]int{20, 10, 30, 5, 15})
+
+    var result []int
+    for v := range heap.All() {
+        result = append(result, v)
+    }
+    assert.Equal(t, heap.ToSlice(), result, "All should follow the underlying array order")
+}
+
+// TestHeap_Sorted tests ranging over the heap in priority order
+func TestHeap_Sorted(t *testing.T) {
+    heap := NewMaxHeap[int](func(a, b int) int {
+        return a - b
+    })
+    for _, elem := range []int{20, 10, 30, 5, 15} {
+        heap.Push(elem)
+    }
+
+    assert.Equal(t, []int{30, 20, 15, 10, 5}, slices.Collect(heap.Sorted()))
+    assert.Equal(t, 5, heap.Size(), "Sorted should not modify original heap")
+}
+
+// TestHeap_IteratorPanicWrapsSentinel tests that exhausted iterators panic with errs.ErrExhausted
+func TestHeap_IteratorPanicWrapsSentinel(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+
+    for _, it := range []iterator.Iterator[int]{heap.Iterator(), heap.SortedIterator()} {
+        func() {
+            defer func() {
+                err, ok := recover().(error)
+                assert.True(t, ok, "Next should panic with an error")
+                assert.ErrorIs(t, err, errs.ErrExhausted)
+            }()
+            it.Next()
+        }()
+    }
+}
+
+// TestHeap_IteratorConcurrentModification tests that the level order iterator is fail-fast
+func TestHeap_IteratorConcurrentModification(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+    heap.Heapify([]int{5, 3, 8})
+
+    modifications := map[string]func(){
+        "Push":    func() { heap.Push(1) },
+        "Pop":     func() { heap.Pop() },
+        "Clear":   func() { heap.Clear() },
+        "Heapify": func() { heap.Heapify([]int{9}) },
+        "Merge":   func() { heap.Merge(NewMinHeap[int](func(a, b int) int { return a - b })) },
+    }
+    for name, modify := range modifications {
+        heap.Heapify([]int{5, 3, 8})
+        it := heap.Iterator()
+        it.Next()
+        modify()
+
+        func() {
+            defer func() {
+                err, ok := recover().(error)
+                assert.True(t, ok, "%s: HasNext should panic with an error", name)
+                assert.ErrorIs(t, err, errs.ErrConcurrentModification, name)
+            }()
+            it.HasNext()
+        }()
+    }
+
+    // Reading the heap does not invalidate the iterator
+    heap.Heapify([]int{5, 3, 8})
+    it := heap.Iterator()
+    heap.Peek()
+    heap.ToSlice()
+    assert.Equal(t, 3, len(iterator.Collect(it)))
+}
+
+// TestHeap_AllConcurrentModification tests that modifying the heap while ranging panics
+func TestHeap_AllConcurrentModification(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+    heap.Heapify([]int{5, 3, 8})
+
+    assert.PanicsWithError(t, errConcurrentModification.Error(), func() {
+        for v := range heap.All() {
+            heap.Push(v)
+        }
+    })
+}
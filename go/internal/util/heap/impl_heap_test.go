@@ -271,6 +271,60 @@ func TestHeap_ToSlice(t *testing.T) {
     }
 }
 
+// TestHeap_HeapifyCopies tests that Heapify neither reorders nor keeps the caller's slice
+func TestHeap_HeapifyCopies(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+
+    elements := []int{20, 15, 10, 17}
+    heap.Heapify(elements)
+    assert.Equal(t, []int{20, 15, 10, 17}, elements, "Heapify should not reorder the caller's slice")
+
+    elements[0] = -1
+    assert.NoError(t, heap.Validate(), "writing to the caller's slice should not affect the heap")
+    val, _ := heap.Peek()
+    assert.Equal(t, 10, val)
+}
+
+// TestHeap_HeapifyInPlace tests that HeapifyInPlace takes over the caller's slice
+func TestHeap_HeapifyInPlace(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+
+    elements := []int{20, 15, 10, 17}
+    heap.HeapifyInPlace(elements)
+    assert.Equal(t, 10, elements[0], "HeapifyInPlace should reorder the slice itself")
+    assert.Equal(t, &elements[0], &heap.Unsafe()[0], "HeapifyInPlace should not copy")
+}
+
+// TestHeap_ToSliceCopies tests that the slice returned by ToSlice is owned by the caller
+func TestHeap_ToSliceCopies(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+    heap.Heapify([]int{3, 1, 2})
+
+    slice := heap.ToSlice()
+    slice[0] = 100
+    val, _ := heap.Peek()
+    assert.Equal(t, 1, val, "writing to ToSlice's result should not affect the heap")
+    assert.NoError(t, heap.Validate())
+}
+
+// TestHeap_Unsafe tests the zero-copy view
+func TestHeap_Unsafe(t *testing.T) {
+    heap := NewMinHeap[int](func(a, b int) int {
+        return a - b
+    })
+    heap.Heapify([]int{3, 1, 2})
+
+    view := heap.Unsafe()
+    assert.Equal(t, heap.ToSlice(), view)
+    assert.Equal(t, &view[0], &heap.Unsafe()[0], "Unsafe should return the array itself")
+}
+
 // TestMinHeap_WithStrings tests min-heap with string type
 func TestMinHeap_WithStrings(t *testing.T) {
     heap := NewMinHeap[string](func(a, b string) int {
@@ -0,0 +1,350 @@
+package heap
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
+
+// indexedEntry is a value stored in an IndexedHeap, together with its key
+type indexedEntry[K comparable, T any] struct {
+    key   K
+    value T
+}
+
+// IndexedHeap is a heap where every element is identified by a key, so its priority can be
+// changed (or the element removed) while it is in the heap.
+//
+// Next to the array, a map tracks the slot of every key. Every swap made while sifting also
+// updates the map, so an element can be found in O(1) and re-sifted from its slot in O(log n):
+//
+//   data:   [0]=(B,1)  [1]=(A,5)  [2]=(C,3)
+//   index:  A -> 1, B -> 0, C -> 2
+//
+//               (B,1)
+//              /     \
+//           (A,5)   (C,3)
+//
+//   DecreaseKey(A, 0): data[1] = (A,0), sift up from index[A] = 1
+//
+//               (A,0)
+//              /     \
+//           (B,1)   (C,3)
+//
+// This is the structure Dijkstra/Prim need: instead of pushing a duplicate entry whenever a
+// shorter distance is found, the existing entry is updated, so the heap never holds more than
+// one entry per vertex.
+//
+// IndexedHeap also satisfies the Heap interface. Push and Heapify derive the key of each value
+// with the keyOf function given to the constructor; pushing a value whose key is already in the
+// heap replaces the previous value (like Update).
+type IndexedHeap[K comparable, T any] struct {
+    data       []indexedEntry[K, T]
+    index      map[K]int        // Slot of every key in data
+    keyOf      func(T) K        // Key of a value, used by Push and Heapify
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+    isMaxHeap  bool
+}
+
+// NewIndexedMinHeap creates and returns a new empty indexed min-heap.
+func NewIndexedMinHeap[K comparable, T any](keyOf func(T) K, comparator func(a, b T) int) *IndexedHeap[K, T] {
+    return &IndexedHeap[K, T]{index: make(map[K]int), keyOf: keyOf, comparator: comparator, isMaxHeap: false}
+}
+
+// NewIndexedMaxHeap creates and returns a new empty indexed max-heap.
+func NewIndexedMaxHeap[K comparable, T any](keyOf func(T) K, comparator func(a, b T) int) *IndexedHeap[K, T] {
+    return &IndexedHeap[K, T]{index: make(map[K]int), keyOf: keyOf, comparator: comparator, isMaxHeap: true}
+}
+
+// Compile-time check to ensure IndexedHeap implements the Heap interface
+var _ Heap[string] = (*IndexedHeap[string, string])(nil)
+
+// hasHigherPriority return true if value a has higher priority than value b
+func (h *IndexedHeap[K, T]) hasHigherPriority(a, b T) bool {
+    cmp := h.comparator(a, b)
+    if h.isMaxHeap {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+// isHigherPriority return true if the element on i-th position has higher priority
+// than the element on j-th position
+func (h *IndexedHeap[K, T]) isHigherPriority(i, j int) bool {
+    return h.hasHigherPriority(h.data[i].value, h.data[j].value)
+}
+
+// swap exchanges two slots, keeping the index in sync
+func (h *IndexedHeap[K, T]) swap(i, j int) {
+    h.data[i], h.data[j] = h.data[j], h.data[i]
+    h.index[h.data[i].key] = i
+    h.index[h.data[j].key] = j
+}
+
+// siftUp moves the given node up the tree while it has higher priority than its parent
+func (h *IndexedHeap[K, T]) siftUp(index int) {
+    for index > 0 && h.isHigherPriority(index, parent(index)) {
+        h.swap(index, parent(index))
+        index = parent(index)
+    }
+}
+
+// siftDown moves the given node down the tree while a child has higher priority
+func (h *IndexedHeap[K, T]) siftDown(index int) {
+    n := len(h.data)
+    for {
+        best := index
+        if left := leftChild(index); left < n && h.isHigherPriority(left, best) {
+            best = left
+        }
+        if right := rightChild(index); right < n && h.isHigherPriority(right, best) {
+            best = right
+        }
+        if best == index {
+            return
+        }
+        h.swap(index, best)
+        index = best
+    }
+}
+
+// fix restores the heap property for a slot whose value changed, in either direction
+func (h *IndexedHeap[K, T]) fix(index int) {
+    if index > 0 && h.isHigherPriority(index, parent(index)) {
+        h.siftUp(index)
+    } else {
+        h.siftDown(index)
+    }
+}
+
+// removeAt deletes the entry in the given slot, moving the last entry into it
+func (h *IndexedHeap[K, T]) removeAt(index int) indexedEntry[K, T] {
+    last := len(h.data) - 1
+    removed := h.data[index]
+    if index != last {
+        h.swap(index, last)
+    }
+    h.data[last] = indexedEntry[K, T]{}
+    h.data = h.data[:last]
+    delete(h.index, removed.key)
+
+    if index < len(h.data) {
+        h.fix(index)
+    }
+    return removed
+}
+
+// Update sets the value of key, inserting it if absent, and moves it to its new position.
+// The priority can go either way.
+//
+// Time complexity: O(log n)
+func (h *IndexedHeap[K, T]) Update(key K, value T) {
+    if i, ok := h.index[key]; ok {
+        h.data[i].value = value
+        h.fix(i)
+        return
+    }
+    h.data = append(h.data, indexedEntry[K, T]{key: key, value: value})
+    h.index[key] = len(h.data) - 1
+    h.siftUp(len(h.data) - 1)
+}
+
+// DecreaseKey gives key a new value with a higher (or equal) priority: smaller in a min-heap,
+// larger in a max-heap. This is the "relax" step of Dijkstra and Prim.
+// Returns false, leaving the heap unchanged, if key is absent or value has a lower priority.
+//
+// Time complexity: O(log n)
+func (h *IndexedHeap[K, T]) DecreaseKey(key K, value T) bool {
+    i, ok := h.index[key]
+    if !ok || h.hasHigherPriority(h.data[i].value, value) {
+        return false
+    }
+    h.data[i].value = value
+    h.siftUp(i)
+    return true
+}
+
+// Remove deletes key from the heap and returns its value.
+// Returns the zero value and false if key is absent.
+//
+// Time complexity: O(log n)
+func (h *IndexedHeap[K, T]) Remove(key K) (T, bool) {
+    i, ok := h.index[key]
+    if !ok {
+        return *new(T), false
+    }
+    return h.removeAt(i).value, true
+}
+
+// Contains checks if key is in the heap.
+//
+// Time complexity: O(1)
+func (h *IndexedHeap[K, T]) Contains(key K) bool {
+    _, ok := h.index[key]
+    return ok
+}
+
+// Get returns the value of key, without removing it.
+//
+// Time complexity: O(1)
+func (h *IndexedHeap[K, T]) Get(key K) (T, bool) {
+    i, ok := h.index[key]
+    if !ok {
+        return *new(T), false
+    }
+    return h.data[i].value, true
+}
+
+// PeekEntry returns the key and value of the root, without removing it.
+func (h *IndexedHeap[K, T]) PeekEntry() (K, T, bool) {
+    if len(h.data) == 0 {
+        return *new(K), *new(T), false
+    }
+    return h.data[0].key, h.data[0].value, true
+}
+
+// PopEntry removes the root and returns its key and value.
+func (h *IndexedHeap[K, T]) PopEntry() (K, T, bool) {
+    if len(h.data) == 0 {
+        return *new(K), *new(T), false
+    }
+    entry := h.removeAt(0)
+    return entry.key, entry.value, true
+}
+
+// -- Heap interface --
+
+func (h *IndexedHeap[K, T]) Peek() (T, bool) {
+    _, value, ok := h.PeekEntry()
+    return value, ok
+}
+
+// Push inserts value under keyOf(value), replacing the previous value of that key if present.
+func (h *IndexedHeap[K, T]) Push(value T) {
+    h.Update(h.keyOf(value), value)
+}
+
+func (h *IndexedHeap[K, T]) Pop() (T, bool) {
+    _, value, ok := h.PopEntry()
+    return value, ok
+}
+
+func (h *IndexedHeap[K, T]) Size() int {
+    return len(h.data)
+}
+
+func (h *IndexedHeap[K, T]) IsEmpty() bool {
+    return len(h.data) == 0
+}
+
+func (h *IndexedHeap[K, T]) Clear() {
+    h.data = nil
+    h.index = make(map[K]int)
+}
+
+// Heapify replaces the content of the heap with elements, in O(n).
+// When several elements share a key, the last one wins.
+func (h *IndexedHeap[K, T]) Heapify(elements []T) {
+    h.data = make([]indexedEntry[K, T], 0, len(elements))
+    h.index = make(map[K]int, len(elements))
+    for _, value := range elements {
+        key := h.keyOf(value)
+        if i, ok := h.index[key]; ok {
+            h.data[i].value = value
+            continue
+        }
+        h.index[key] = len(h.data)
+        h.data = append(h.data, indexedEntry[K, T]{key: key, value: value})
+    }
+
+    for i := len(h.data)/2 - 1; i >= 0; i-- {
+        h.siftDown(i)
+    }
+}
+
+// Merge moves the elements of other into this heap, leaving other empty. The keys of another
+// IndexedHeap are kept; values of any other heap are keyed with keyOf. When a key is in both
+// heaps, the value from other wins.
+//
+// Time complexity: O(m log(n + m))
+func (h *IndexedHeap[K, T]) Merge(other Heap[T]) {
+    if other == nil || other == Heap[T](h) {
+        return
+    }
+    if indexed, ok := other.(*IndexedHeap[K, T]); ok {
+        for key, value := range indexed.Keys() {
+            h.Update(key, value)
+        }
+    } else {
+        for _, value := range other.ToSlice() {
+            h.Push(value)
+        }
+    }
+    other.Clear()
+}
+
+// ToSlice returns the values in the underlying array order, in a new slice.
+func (h *IndexedHeap[K, T]) ToSlice() []T {
+    values := make([]T, len(h.data))
+    for i, entry := range h.data {
+        values[i] = entry.value
+    }
+    return values
+}
+
+// Iterator returns an iterator that traverses the values in the underlying array order.
+func (h *IndexedHeap[K, T]) Iterator() iterator.Iterator[T] {
+    return newHeapIterator(h.ToSlice())
+}
+
+// SortedIterator Create an iterator that returns the values in priority order. Performs a copy of the data.
+func (h *IndexedHeap[K, T]) SortedIterator() iterator.Iterator[T] {
+    return newHeapSortedIterator[T](h)
+}
+
+// All returns a sequence over the values, in the underlying array order.
+func (h *IndexedHeap[K, T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, entry := range h.data {
+            if !yield(entry.value) {
+                return
+            }
+        }
+    }
+}
+
+// Sorted returns a sequence in priority order, popping from a copy of the heap.
+func (h *IndexedHeap[K, T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
+// Keys returns a sequence of (key, value) pairs, in the underlying array order.
+func (h *IndexedHeap[K, T]) Keys() iter.Seq2[K, T] {
+    return func(yield func(K, T) bool) {
+        for _, entry := range h.data {
+            if !yield(entry.key, entry.value) {
+                return
+            }
+        }
+    }
+}
+
+// Clone returns a plain ImplHeap with the same values and order, as required by the Heap
+// interface. The keys are dropped: use CloneIndexed to keep them.
+// Complexity: O(n)
+func (h *IndexedHeap[K, T]) Clone() *ImplHeap[T] {
+    // The array already satisfies the heap property for the same comparator
+    return &ImplHeap[T]{data: h.ToSlice(), comparator: h.comparator, isMaxHeap: h.isMaxHeap}
+}
+
+// CloneIndexed returns a copy of the heap, keys included.
+// Complexity: O(n)
+func (h *IndexedHeap[K, T]) CloneIndexed() *IndexedHeap[K, T] {
+    index := make(map[K]int, len(h.index))
+    for key, i := range h.index {
+        index[key] = i
+    }
+    data := make([]indexedEntry[K, T], len(h.data))
+    copy(data, h.data)
+    return &IndexedHeap[K, T]{data: data, index: index, keyOf: h.keyOf, comparator: h.comparator, isMaxHeap: h.isMaxHeap}
+}
@@ -0,0 +1,279 @@
+package heap
+
+import (
+    "math/rand"
+    "testing"
+
+    "interview_go/internal/util/graph"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// task is a value with an identity (name) and a priority
+type task struct {
+    name     string
+    priority int
+}
+
+func taskName(t task) string {
+    return t.name
+}
+
+func compareTask(a, b task) int {
+    return a.priority - b.priority
+}
+
+// assertIndexed checks the heap property and that the index points to the right slots
+func assertIndexed[K comparable, T any](t *testing.T, h *IndexedHeap[K, T]) {
+    t.Helper()
+    assert.Equal(t, len(h.data), len(h.index), "index should have one entry per element")
+    for i, entry := range h.data {
+        assert.Equal(t, i, h.index[entry.key], "index should point to the slot of the key")
+        if i > 0 {
+            assert.False(t, h.isHigherPriority(i, parent(i)), "child should not outrank its parent")
+        }
+    }
+}
+
+// TestIndexedHeap_PushPop tests the plain Heap operations
+func TestIndexedHeap_PushPop(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    assert.True(t, h.IsEmpty())
+    _, ok := h.Pop()
+    assert.False(t, ok, "Pop on empty heap should return false")
+
+    h.Push(task{"a", 5})
+    h.Push(task{"b", 1})
+    h.Push(task{"c", 3})
+    assertIndexed(t, h)
+    assert.Equal(t, 3, h.Size())
+
+    top, ok := h.Peek()
+    assert.True(t, ok)
+    assert.Equal(t, task{"b", 1}, top)
+
+    var names []string
+    for !h.IsEmpty() {
+        value, _ := h.Pop()
+        names = append(names, value.name)
+        assertIndexed(t, h)
+    }
+    assert.Equal(t, []string{"b", "c", "a"}, names)
+}
+
+// TestIndexedHeap_PushExistingKey tests that pushing a known key replaces its value
+func TestIndexedHeap_PushExistingKey(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Push(task{"a", 5})
+    h.Push(task{"b", 3})
+    h.Push(task{"a", 1})
+
+    assert.Equal(t, 2, h.Size(), "Push should not duplicate a key")
+    top, _ := h.Peek()
+    assert.Equal(t, task{"a", 1}, top)
+    assertIndexed(t, h)
+}
+
+// TestIndexedHeap_Update tests moving an element up and down
+func TestIndexedHeap_Update(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Heapify([]task{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}})
+
+    // Down: the root becomes the largest
+    h.Update("a", task{"a", 10})
+    assertIndexed(t, h)
+    top, _ := h.Peek()
+    assert.Equal(t, "b", top.name)
+
+    // Up: a leaf becomes the smallest
+    h.Update("e", task{"e", 0})
+    assertIndexed(t, h)
+    top, _ = h.Peek()
+    assert.Equal(t, "e", top.name)
+
+    // Unknown key is inserted
+    h.Update("f", task{"f", -1})
+    assertIndexed(t, h)
+    assert.Equal(t, 6, h.Size())
+    top, _ = h.Peek()
+    assert.Equal(t, "f", top.name)
+}
+
+// TestIndexedHeap_DecreaseKey tests that only priority improvements are applied
+func TestIndexedHeap_DecreaseKey(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Heapify([]task{{"a", 1}, {"b", 5}, {"c", 3}})
+
+    assert.True(t, h.DecreaseKey("b", task{"b", 0}))
+    assertIndexed(t, h)
+    top, _ := h.Peek()
+    assert.Equal(t, task{"b", 0}, top)
+
+    assert.False(t, h.DecreaseKey("c", task{"c", 7}), "DecreaseKey should reject a lower priority")
+    value, _ := h.Get("c")
+    assert.Equal(t, 3, value.priority, "rejected DecreaseKey should not change the value")
+
+    assert.False(t, h.DecreaseKey("z", task{"z", 0}), "DecreaseKey should reject an unknown key")
+    assert.False(t, h.Contains("z"))
+
+    // Max-heap: an improvement is a larger value
+    m := NewIndexedMaxHeap(taskName, compareTask)
+    m.Heapify([]task{{"a", 1}, {"b", 5}})
+    assert.False(t, m.DecreaseKey("a", task{"a", 0}))
+    assert.True(t, m.DecreaseKey("a", task{"a", 9}))
+    top, _ = m.Peek()
+    assert.Equal(t, "a", top.name)
+}
+
+// TestIndexedHeap_Remove tests removing arbitrary keys
+func TestIndexedHeap_Remove(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Heapify([]task{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}, {"f", 6}})
+
+    value, ok := h.Remove("b")
+    assert.True(t, ok)
+    assert.Equal(t, task{"b", 2}, value)
+    assert.False(t, h.Contains("b"))
+    assertIndexed(t, h)
+
+    // Root and last slot
+    _, ok = h.Remove("a")
+    assert.True(t, ok)
+    assertIndexed(t, h)
+    _, ok = h.Remove("f")
+    assert.True(t, ok)
+    assertIndexed(t, h)
+
+    _, ok = h.Remove("a")
+    assert.False(t, ok, "Remove should return false for an absent key")
+
+    var priorities []int
+    for v := range h.Sorted() {
+        priorities = append(priorities, v.priority)
+    }
+    assert.Equal(t, []int{3, 4, 5}, priorities)
+}
+
+// TestIndexedHeap_GetContains tests the lookups by key
+func TestIndexedHeap_GetContains(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    _, ok := h.Get("a")
+    assert.False(t, ok)
+
+    h.Push(task{"a", 1})
+    assert.True(t, h.Contains("a"))
+    value, ok := h.Get("a")
+    assert.True(t, ok)
+    assert.Equal(t, task{"a", 1}, value)
+
+    h.Clear()
+    assert.False(t, h.Contains("a"))
+    assert.True(t, h.IsEmpty())
+}
+
+// TestIndexedHeap_Heapify tests building from a slice, with duplicated keys
+func TestIndexedHeap_Heapify(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Heapify([]task{{"a", 5}, {"b", 4}, {"a", 0}, {"c", 3}})
+    assertIndexed(t, h)
+    assert.Equal(t, 3, h.Size(), "duplicated keys should be collapsed")
+
+    key, value, ok := h.PeekEntry()
+    assert.True(t, ok)
+    assert.Equal(t, "a", key)
+    assert.Equal(t, 0, value.priority, "the last duplicate should win")
+}
+
+// TestIndexedHeap_Clone tests both clones are independent of the original
+func TestIndexedHeap_Clone(t *testing.T) {
+    h := NewIndexedMinHeap(taskName, compareTask)
+    h.Heapify([]task{{"a", 3}, {"b", 1}, {"c", 2}})
+
+    plain := h.Clone()
+    indexed := h.CloneIndexed()
+    h.Clear()
+
+    assert.Equal(t, 3, plain.Size())
+    var order []string
+    for v := range plain.Sorted() {
+        order = append(order, v.name)
+    }
+    assert.Equal(t, []string{"b", "c", "a"}, order)
+
+    assertIndexed(t, indexed)
+    assert.True(t, indexed.DecreaseKey("a", task{"a", 0}))
+    key, _, _ := indexed.PopEntry()
+    assert.Equal(t, "a", key)
+}
+
+// TestIndexedHeap_Random compares against a sorted reference under random operations
+func TestIndexedHeap_Random(t *testing.T) {
+    rnd := rand.New(rand.NewSource(42))
+    h := NewIndexedMinHeap(func(v [2]int) int { return v[0] }, func(a, b [2]int) int { return a[1] - b[1] })
+    reference := make(map[int]int)
+
+    for i := 0; i < 2000; i++ {
+        key := rnd.Intn(50)
+        switch rnd.Intn(3) {
+        case 0:
+            priority := rnd.Intn(1000)
+            h.Update(key, [2]int{key, priority})
+            reference[key] = priority
+        case 1:
+            _, ok := h.Remove(key)
+            _, expected := reference[key]
+            assert.Equal(t, expected, ok)
+            delete(reference, key)
+        case 2:
+            if _, value, ok := h.PopEntry(); ok {
+                for _, priority := range reference {
+                    assert.LessOrEqual(t, value[1], priority, "Pop should return the minimum")
+                }
+                delete(reference, value[0])
+            }
+        }
+    }
+    assertIndexed(t, h)
+    assert.Equal(t, len(reference), h.Size())
+}
+
+// TestIndexedHeap_Dijkstra tests the typical use: one entry per vertex, relaxed in place
+func TestIndexedHeap_Dijkstra(t *testing.T) {
+    g := graph.NewGraph[string, int]()
+    g.AddEdge("A", "B", 4)
+    g.AddEdge("A", "C", 1)
+    g.AddEdge("C", "B", 2)
+    g.AddEdge("B", "D", 1)
+    g.AddEdge("C", "D", 5)
+    g.AddEdge("D", "E", 3)
+
+    type distance struct {
+        vertex string
+        cost   int
+    }
+    pq := NewIndexedMinHeap(func(d distance) string { return d.vertex }, func(a, b distance) int { return a.cost - b.cost })
+    pq.Push(distance{"A", 0})
+
+    result := make(map[string]int)
+    maxSize := 0
+    for !pq.IsEmpty() {
+        maxSize = max(maxSize, pq.Size())
+        current, _ := pq.Pop()
+        result[current.vertex] = current.cost
+
+        for _, edge := range g.GetEdgesFrom(current.vertex) {
+            if _, done := result[edge.To]; done {
+                continue
+            }
+            next := distance{edge.To, current.cost + edge.Weight}
+            if !pq.Contains(edge.To) {
+                pq.Push(next)
+            } else {
+                pq.DecreaseKey(edge.To, next)
+            }
+        }
+    }
+
+    assert.Equal(t, map[string]int{"A": 0, "B": 3, "C": 1, "D": 4, "E": 7}, result)
+    assert.LessOrEqual(t, maxSize, len(g.Vertices), "the heap should hold at most one entry per vertex")
+}
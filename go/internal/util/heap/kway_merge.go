@@ -0,0 +1,70 @@
+package heap
+
+import (
+    "interview_go/internal/util/iterator"
+)
+
+// mergeHead is the next element of one of the sources of a KWayMerge
+type mergeHead[T any] struct {
+    value  T
+    source int // Position of the source, used to break ties
+}
+
+// kWayMergeIterator holds one element per non exhausted source in a min-heap. Next pops the
+// smallest and refills the heap from the same source:
+//
+//   sources:  [1, 4, 7]  [2, 5]  [3, 6, 9]
+//   heap:     1(s0) 2(s1) 3(s2)
+//   Next() -> 1, pull 4 from s0 -> heap: 2(s1) 3(s2) 4(s0)
+//   Next() -> 2, pull 5 from s1 -> heap: 3(s2) 4(s0) 5(s1)
+//   ...
+//
+// Every Next is O(log k), for k sources.
+type kWayMergeIterator[T any] struct {
+    sources []iterator.Iterator[T]
+    heads   *ImplHeap[mergeHead[T]]
+    started bool
+}
+
+// KWayMerge returns an iterator over the elements of all sources, in the order given by comparator.
+// Every source must already be sorted by the same comparator, for instance BinaryTree in-order
+// iterators, heap SortedIterators or the lines of sorted log shards.
+//
+// The merge is lazy: the first element of every source is only pulled on the first call to
+// HasNext or Next, and from then on one element is pulled per element returned.
+// The merge is stable: equal elements are returned in the order of their sources.
+func KWayMerge[T any](comparator func(a, b T) int, sources ...iterator.Iterator[T]) iterator.Iterator[T] {
+    heads := NewMinHeap(func(a, b mergeHead[T]) int {
+        if cmp := comparator(a.value, b.value); cmp != 0 {
+            return cmp
+        }
+        return a.source - b.source
+    })
+    return &kWayMergeIterator[T]{sources: sources, heads: heads}
+}
+
+// pull pushes the next element of the given source, if any
+func (k *kWayMergeIterator[T]) pull(source int) {
+    if k.sources[source].HasNext() {
+        k.heads.Push(mergeHead[T]{value: k.sources[source].Next(), source: source})
+    }
+}
+
+func (k *kWayMergeIterator[T]) HasNext() bool {
+    if !k.started {
+        k.started = true
+        for i := range k.sources {
+            k.pull(i)
+        }
+    }
+    return !k.heads.IsEmpty()
+}
+
+func (k *kWayMergeIterator[T]) Next() T {
+    if !k.HasNext() {
+        panic(errExhausted)
+    }
+    head, _ := k.heads.Pop()
+    k.pull(head.source)
+    return head.value
+}
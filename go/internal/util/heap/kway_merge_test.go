@@ -0,0 +1,104 @@
+package heap
+
+import (
+    "slices"
+    "testing"
+
+    "interview_go/internal/util/iterator"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestKWayMerge tests merging several sorted sources, including empty ones
+func TestKWayMerge(t *testing.T) {
+    merged := KWayMerge(compareInt,
+        iterator.FromSlice([]int{1, 4, 7}),
+        iterator.FromSlice([]int{}),
+        iterator.FromSlice([]int{2, 5}),
+        iterator.FromSlice([]int{3, 6, 9, 10}),
+    )
+    assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 9, 10}, iterator.Collect(merged))
+    assert.Panics(t, func() { merged.Next() }, "Next should panic after iteration completes")
+}
+
+// TestKWayMerge_NoSources tests that an empty merge is exhausted
+func TestKWayMerge_NoSources(t *testing.T) {
+    assert.False(t, KWayMerge[int](compareInt).HasNext())
+}
+
+// TestKWayMerge_Stable tests that equal elements keep the order of their sources
+func TestKWayMerge_Stable(t *testing.T) {
+    type line struct {
+        timestamp int
+        shard     string
+    }
+    byTimestamp := func(a, b line) int { return a.timestamp - b.timestamp }
+
+    merged := KWayMerge(byTimestamp,
+        iterator.FromSlice([]line{{1, "a"}, {3, "a"}}),
+        iterator.FromSlice([]line{{1, "b"}, {2, "b"}, {3, "b"}}),
+    )
+    assert.Equal(t, []line{{1, "a"}, {1, "b"}, {2, "b"}, {3, "a"}, {3, "b"}}, iterator.Collect(merged))
+}
+
+// TestKWayMerge_Lazy tests that sources are only pulled as needed
+func TestKWayMerge_Lazy(t *testing.T) {
+    first := iterator.FromSlice([]int{1, 2, 3})
+    second := iterator.FromSlice([]int{10, 20})
+    merged := KWayMerge(compareInt, first, second)
+
+    assert.True(t, first.HasNext(), "nothing should be pulled before HasNext")
+    assert.Equal(t, 1, merged.Next())
+    // One element per source is buffered (2 and 10): 3 and 20 are still in the sources
+    assert.Equal(t, 3, first.Next())
+    assert.Equal(t, 20, second.Next())
+}
+
+// TestKWayMerge_SortedIterators tests merging heaps in priority order
+func TestKWayMerge_SortedIterators(t *testing.T) {
+    a := NewMinHeap(compareInt)
+    a.Heapify([]int{9, 1, 5})
+    b := NewPairingMinHeap(compareInt)
+    b.Heapify([]int{8, 2, 2})
+
+    merged := KWayMerge(compareInt, a.SortedIterator(), b.SortedIterator())
+    assert.Equal(t, []int{1, 2, 2, 5, 8, 9}, slices.Collect(iterator.ToSeq(merged)))
+}
+
+// TestMerge tests Merge across every implementation, in both directions
+func TestMerge(t *testing.T) {
+    for _, target := range heapImplementations {
+        for _, source := range heapImplementations {
+            t.Run(target.name+"<-"+source.name, func(t *testing.T) {
+                h := target.minHeap()
+                h.Heapify([]int{5, 1, 9})
+                other := source.minHeap()
+                other.Heapify([]int{4, 0, 7, 1})
+
+                h.Merge(other)
+                assert.True(t, other.IsEmpty(), "Merge should leave other empty")
+                assert.Equal(t, 7, h.Size())
+                assert.Equal(t, []int{0, 1, 1, 4, 5, 7, 9}, popAll(h))
+            })
+        }
+    }
+}
+
+// TestMerge_EdgeCases tests merging with empty heaps and with itself
+func TestMerge_EdgeCases(t *testing.T) {
+    for _, impl := range heapImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            h := impl.maxHeap()
+            h.Merge(impl.maxHeap())
+            assert.True(t, h.IsEmpty())
+
+            h.Heapify([]int{1, 2})
+            h.Merge(h)
+            assert.Equal(t, 2, h.Size(), "merging a heap with itself should do nothing")
+
+            empty := impl.maxHeap()
+            empty.Merge(h)
+            assert.Equal(t, []int{2, 1}, popAll(empty))
+        })
+    }
+}
@@ -0,0 +1,273 @@
+package heap
+
+import (
+    "iter"
+    "math/bits"
+    "slices"
+
+    "interview_go/internal/util/iterator"
+)
+
+// MinMaxHeap is a double-ended heap: both the smallest and the largest element are available in
+// O(1), and can be removed in O(log n).
+//
+// It uses the same array layout as ImplHeap, but the levels alternate between min and max:
+//
+//                  1          <- min level: smaller than every descendant
+//                /   \
+//              20     18      <- max level: larger than every descendant
+//             /  \   /  \
+//            4    8 6    3    <- min level
+//           / \
+//         15  12              <- max level
+//
+// The minimum is the root, and the maximum is one of its two children.
+//
+// Sift operations compare an element with its grandparent (or grandchildren), which are on a
+// level of the same kind, and with its parent only to switch between the min and max chains.
+type MinMaxHeap[T any] struct {
+    data       []T
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+}
+
+// NewMinMaxHeap creates and returns a new empty min-max heap.
+func NewMinMaxHeap[T any](comparator func(a, b T) int) *MinMaxHeap[T] {
+    return &MinMaxHeap[T]{data: make([]T, 0), comparator: comparator}
+}
+
+// isMinLevel returns true if the node is on an even level (the root is level 0)
+func isMinLevel(i int) bool {
+    return (bits.Len(uint(i+1))-1)%2 == 0
+}
+
+// isBefore returns true if the element on i-th position should be closer to the root than the
+// element on j-th position: smaller on a min level, larger on a max level
+func (h *MinMaxHeap[T]) isBefore(i, j int, maxLevel bool) bool {
+    cmp := h.comparator(h.data[i], h.data[j])
+    if maxLevel {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+    h.data[i], h.data[j] = h.data[j], h.data[i]
+}
+
+// siftUp places a new element: first it picks the min or max chain by comparing with its parent,
+// then climbs that chain by grandparents
+func (h *MinMaxHeap[T]) siftUp(index int) {
+    if index == 0 {
+        return
+    }
+
+    maxLevel := !isMinLevel(index)
+    p := parent(index)
+    if h.isBefore(index, p, !maxLevel) {
+        // Belongs to the other kind of level: e.g. on a min level, but larger than the max parent
+        h.swap(index, p)
+        index = p
+        maxLevel = !maxLevel
+    }
+
+    // Climb by grandparents, which are on the same kind of level
+    for index >= 3 {
+        grandparent := parent(parent(index))
+        if !h.isBefore(index, grandparent, maxLevel) {
+            return
+        }
+        h.swap(index, grandparent)
+        index = grandparent
+    }
+}
+
+// siftDown moves an element down the min or max chain of its level, by grandchildren
+//
+// O(log n)
+func (h *MinMaxHeap[T]) siftDown(index int) {
+    maxLevel := !isMinLevel(index)
+    n := len(h.data)
+
+    for {
+        // Find the best among the children and grandchildren
+        best := -1
+        first := leftChild(index)
+        candidates := [...]int{
+            first, first + 1, // Children
+            leftChild(first), rightChild(first), leftChild(first + 1), rightChild(first + 1), // Grandchildren
+        }
+        for _, candidate := range candidates {
+            if candidate < n && (best < 0 || h.isBefore(candidate, best, maxLevel)) {
+                best = candidate
+            }
+        }
+        if best < 0 || !h.isBefore(best, index, maxLevel) {
+            return
+        }
+
+        h.swap(best, index)
+        if best <= first+1 {
+            // A child is on the other kind of level, so it has no descendants to fix
+            return
+        }
+        // The element moved down two levels, and may be out of order with its new parent
+        if h.isBefore(parent(best), best, maxLevel) {
+            h.swap(best, parent(best))
+        }
+        index = best
+    }
+}
+
+// maxIndex returns the position of the largest element, or -1 if the heap is empty
+func (h *MinMaxHeap[T]) maxIndex() int {
+    switch len(h.data) {
+    case 0:
+        return -1
+    case 1:
+        return 0
+    case 2:
+        return 1
+    }
+    if h.comparator(h.data[2], h.data[1]) > 0 {
+        return 2
+    }
+    return 1
+}
+
+// removeAt deletes the element on the given position, moving the last element into it
+func (h *MinMaxHeap[T]) removeAt(index int) T {
+    last := len(h.data) - 1
+    value := h.data[index]
+    h.data[index] = h.data[last]
+    h.data[last] = *new(T)
+    h.data = h.data[:last]
+    if index < len(h.data) {
+        h.siftDown(index)
+    }
+    return value
+}
+
+// Push inserts a new value.
+//
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) Push(value T) {
+    h.data = append(h.data, value)
+    h.siftUp(len(h.data) - 1)
+}
+
+// PeekMin returns the smallest element without removing it.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMin() (T, bool) {
+    if len(h.data) == 0 {
+        return *new(T), false
+    }
+    return h.data[0], true
+}
+
+// PeekMax returns the largest element without removing it.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMax() (T, bool) {
+    i := h.maxIndex()
+    if i < 0 {
+        return *new(T), false
+    }
+    return h.data[i], true
+}
+
+// PopMin removes and returns the smallest element.
+//
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMin() (T, bool) {
+    if len(h.data) == 0 {
+        return *new(T), false
+    }
+    return h.removeAt(0), true
+}
+
+// PopMax removes and returns the largest element.
+//
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMax() (T, bool) {
+    i := h.maxIndex()
+    if i < 0 {
+        return *new(T), false
+    }
+    return h.removeAt(i), true
+}
+
+func (h *MinMaxHeap[T]) Size() int {
+    return len(h.data)
+}
+
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+    return len(h.data) == 0
+}
+
+func (h *MinMaxHeap[T]) Clear() {
+    h.data = make([]T, 0)
+}
+
+// Heapify replaces the content of the heap with a copy of elements.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) Heapify(elements []T) {
+    h.data = slices.Clone(elements)
+    for i := len(h.data)/2 - 1; i >= 0; i-- {
+        h.siftDown(i)
+    }
+}
+
+// ToSlice returns the elements in the underlying array order, in a new slice.
+func (h *MinMaxHeap[T]) ToSlice() []T {
+    return slices.Clone(h.data)
+}
+
+// Iterator returns an iterator over the elements in the underlying array order.
+func (h *MinMaxHeap[T]) Iterator() iterator.Iterator[T] {
+    return newHeapIterator(h.ToSlice())
+}
+
+// All returns a sequence over the elements in the underlying array order.
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, value := range h.data {
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
+
+// Ascending returns a sequence from the smallest to the largest element, popping from a copy.
+func (h *MinMaxHeap[T]) Ascending() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        clone := h.Clone()
+        for !clone.IsEmpty() {
+            value, _ := clone.PopMin()
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
+
+// Descending returns a sequence from the largest to the smallest element, popping from a copy.
+func (h *MinMaxHeap[T]) Descending() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        clone := h.Clone()
+        for !clone.IsEmpty() {
+            value, _ := clone.PopMax()
+            if !yield(value) {
+                return
+            }
+        }
+    }
+}
+
+// Clone returns a copy of the heap.
+// Complexity: O(n)
+func (h *MinMaxHeap[T]) Clone() *MinMaxHeap[T] {
+    return &MinMaxHeap[T]{data: slices.Clone(h.data), comparator: h.comparator}
+}
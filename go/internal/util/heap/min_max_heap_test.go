@@ -0,0 +1,148 @@
+package heap
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// assertMinMax checks that every element is on the right side of all its descendants
+func assertMinMax(t *testing.T, h *MinMaxHeap[int]) {
+    t.Helper()
+    for i := 1; i < len(h.data); i++ {
+        for ancestor := parent(i); ; ancestor = parent(ancestor) {
+            if isMinLevel(ancestor) {
+                assert.LessOrEqual(t, h.data[ancestor], h.data[i], "min node %d above %d", ancestor, i)
+            } else {
+                assert.GreaterOrEqual(t, h.data[ancestor], h.data[i], "max node %d above %d", ancestor, i)
+            }
+            if ancestor == 0 {
+                break
+            }
+        }
+    }
+}
+
+// TestNewMinMaxHeap tests the creation of an empty min-max heap
+func TestNewMinMaxHeap(t *testing.T) {
+    h := NewMinMaxHeap(compareInt)
+    assert.True(t, h.IsEmpty())
+    assert.Equal(t, 0, h.Size())
+
+    _, ok := h.PeekMin()
+    assert.False(t, ok)
+    _, ok = h.PeekMax()
+    assert.False(t, ok)
+    _, ok = h.PopMin()
+    assert.False(t, ok)
+    _, ok = h.PopMax()
+    assert.False(t, ok)
+}
+
+// TestMinMaxHeap_SmallSizes tests PeekMax/PopMax with fewer than 3 elements
+func TestMinMaxHeap_SmallSizes(t *testing.T) {
+    h := NewMinMaxHeap(compareInt)
+    h.Push(5)
+    value, _ := h.PeekMax()
+    assert.Equal(t, 5, value, "with one element, min and max are the same")
+    value, _ = h.PeekMin()
+    assert.Equal(t, 5, value)
+
+    h.Push(3)
+    value, _ = h.PeekMin()
+    assert.Equal(t, 3, value)
+    value, _ = h.PopMax()
+    assert.Equal(t, 5, value)
+    value, _ = h.PopMax()
+    assert.Equal(t, 3, value)
+    assert.True(t, h.IsEmpty())
+}
+
+// TestMinMaxHeap_PushPop tests both ends against a sorted reference
+func TestMinMaxHeap_PushPop(t *testing.T) {
+    rnd := rand.New(rand.NewSource(11))
+    h := NewMinMaxHeap(compareInt)
+    var reference []int
+
+    for i := 0; i < 5000; i++ {
+        switch op := rnd.Intn(4); {
+        case op < 2 || len(reference) == 0:
+            value := rnd.Intn(500)
+            h.Push(value)
+            reference = append(reference, value)
+            slices.Sort(reference)
+        case op == 2:
+            value, ok := h.PopMin()
+            assert.True(t, ok)
+            assert.Equal(t, reference[0], value)
+            reference = reference[1:]
+        default:
+            value, ok := h.PopMax()
+            assert.True(t, ok)
+            assert.Equal(t, reference[len(reference)-1], value)
+            reference = reference[:len(reference)-1]
+        }
+
+        if len(reference) > 0 {
+            low, _ := h.PeekMin()
+            high, _ := h.PeekMax()
+            assert.Equal(t, reference[0], low)
+            assert.Equal(t, reference[len(reference)-1], high)
+        }
+    }
+    assertMinMax(t, h)
+}
+
+// TestMinMaxHeap_Heapify tests building from a slice without modifying it
+func TestMinMaxHeap_Heapify(t *testing.T) {
+    values := randomValues(12, 300)
+    input := slices.Clone(values)
+
+    h := NewMinMaxHeap(compareInt)
+    h.Heapify(input)
+    assert.Equal(t, values, input, "Heapify should not reorder the caller's slice")
+    assertMinMax(t, h)
+
+    sorted := slices.Clone(values)
+    slices.Sort(sorted)
+    assert.Equal(t, sorted, slices.Collect(h.Ascending()))
+    slices.Reverse(sorted)
+    assert.Equal(t, sorted, slices.Collect(h.Descending()))
+    assert.Equal(t, len(values), h.Size(), "sorted sequences should not modify the heap")
+}
+
+// TestMinMaxHeap_Iterators tests the array order traversals
+func TestMinMaxHeap_Iterators(t *testing.T) {
+    h := NewMinMaxHeap(compareInt)
+    h.Heapify([]int{4, 9, 1, 7, 3})
+
+    slice := h.ToSlice()
+    assert.ElementsMatch(t, []int{4, 9, 1, 7, 3}, slice)
+    assert.Equal(t, 1, slice[0])
+    assert.Equal(t, slice, slices.Collect(h.All()))
+
+    var iterated []int
+    for it := h.Iterator(); it.HasNext(); {
+        iterated = append(iterated, it.Next())
+    }
+    assert.Equal(t, slice, iterated)
+
+    // Early exit of the sorted sequences
+    for value := range h.Descending() {
+        assert.Equal(t, 9, value)
+        break
+    }
+}
+
+// TestMinMaxHeap_Clone tests that the clone is independent
+func TestMinMaxHeap_Clone(t *testing.T) {
+    h := NewMinMaxHeap(compareInt)
+    h.Heapify([]int{2, 8, 5})
+    clone := h.Clone()
+    h.Clear()
+
+    assert.True(t, h.IsEmpty())
+    assert.Equal(t, []int{2, 5, 8}, slices.Collect(clone.Ascending()))
+}
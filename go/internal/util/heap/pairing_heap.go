@@ -0,0 +1,229 @@
+package heap
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
+
+// pairingNode is a node of a PairingHeap. The children of a node form a singly linked list:
+// child points to the first one, and every child points to the next through sibling.
+type pairingNode[T any] struct {
+    value   T
+    child   *pairingNode[T]
+    sibling *pairingNode[T]
+}
+
+// PairingHeap is a heap-ordered multi-way tree, where the only structural operation is melding
+// two trees: the root with lower priority becomes the first child of the other.
+//
+// Push melds a single node tree with the root, and Meld melds two roots, both in O(1).
+// Pop removes the root and melds its children in two passes:
+//
+//   children:     c1  c2  c3  c4  c5
+//   1st pass:    (c1+c2) (c3+c4)  c5     left to right, in pairs
+//   2nd pass:    ((c1+c2) + ((c3+c4) + c5))    right to left, into one tree
+//
+// Pop is O(log n) amortized. In practice it is one of the fastest heaps for workloads mixing
+// pushes and melds.
+type PairingHeap[T any] struct {
+    root       *pairingNode[T]
+    size       int
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+    isMaxHeap  bool
+}
+
+// NewPairingMinHeap creates and returns a new empty pairing min-heap.
+func NewPairingMinHeap[T any](comparator func(a, b T) int) *PairingHeap[T] {
+    return &PairingHeap[T]{comparator: comparator, isMaxHeap: false}
+}
+
+// NewPairingMaxHeap creates and returns a new empty pairing max-heap.
+func NewPairingMaxHeap[T any](comparator func(a, b T) int) *PairingHeap[T] {
+    return &PairingHeap[T]{comparator: comparator, isMaxHeap: true}
+}
+
+// Compile-time check to ensure PairingHeap implements the Heap interface
+var _ Heap[string] = (*PairingHeap[string])(nil)
+
+// hasHigherPriority return true if value a has higher priority than value b
+func (h *PairingHeap[T]) hasHigherPriority(a, b T) bool {
+    cmp := h.comparator(a, b)
+    if h.isMaxHeap {
+        return cmp > 0
+    }
+    return cmp < 0
+}
+
+// meld joins two trees and returns the new root. Either can be nil.
+func (h *PairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+    if a == nil {
+        return b
+    }
+    if b == nil {
+        return a
+    }
+    if h.hasHigherPriority(b.value, a.value) {
+        a, b = b, a
+    }
+    // b becomes the first child of a
+    b.sibling = a.child
+    a.child = b
+    return a
+}
+
+// mergePairs melds a list of siblings into a single tree, with the two-pass strategy
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+    // First pass: meld pairs, left to right
+    var pairs []*pairingNode[T]
+    for first != nil {
+        a := first
+        b := a.sibling
+        if b == nil {
+            a.sibling = nil
+            pairs = append(pairs, a)
+            break
+        }
+        first = b.sibling
+        a.sibling, b.sibling = nil, nil
+        pairs = append(pairs, h.meld(a, b))
+    }
+
+    // Second pass: meld the pairs into one tree, right to left
+    var root *pairingNode[T]
+    for i := len(pairs) - 1; i >= 0; i-- {
+        root = h.meld(pairs[i], root)
+    }
+    return root
+}
+
+// Meld moves all elements of other into this heap, in O(1), leaving other empty.
+// Both heaps are expected to share the same ordering; this heap's comparator is used.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+    if other == nil || other == h {
+        return
+    }
+    h.root = h.meld(h.root, other.root)
+    h.size += other.size
+    other.root = nil
+    other.size = 0
+}
+
+// Merge moves the elements of other into this heap, leaving other empty. Another PairingHeap with
+// the same orientation is melded in O(1); any other heap is drained with O(1) pushes, in O(m).
+func (h *PairingHeap[T]) Merge(other Heap[T]) {
+    if other == nil || other == Heap[T](h) {
+        return
+    }
+    if same, ok := other.(*PairingHeap[T]); ok && same.isMaxHeap == h.isMaxHeap {
+        h.Meld(same)
+        return
+    }
+    for _, value := range other.ToSlice() {
+        h.Push(value)
+    }
+    other.Clear()
+}
+
+func (h *PairingHeap[T]) Peek() (T, bool) {
+    if h.root == nil {
+        return *new(T), false
+    }
+    return h.root.value, true
+}
+
+// Push inserts value in O(1).
+func (h *PairingHeap[T]) Push(value T) {
+    h.root = h.meld(h.root, &pairingNode[T]{value: value})
+    h.size++
+}
+
+// Pop removes the root in O(log n) amortized.
+func (h *PairingHeap[T]) Pop() (T, bool) {
+    if h.root == nil {
+        return *new(T), false
+    }
+    value := h.root.value
+    h.root = h.mergePairs(h.root.child)
+    h.size--
+    return value, true
+}
+
+func (h *PairingHeap[T]) Size() int {
+    return h.size
+}
+
+func (h *PairingHeap[T]) IsEmpty() bool {
+    return h.size == 0
+}
+
+func (h *PairingHeap[T]) Clear() {
+    h.root = nil
+    h.size = 0
+}
+
+// Heapify replaces the content of the heap with elements. With O(1) Push this is O(n).
+func (h *PairingHeap[T]) Heapify(elements []T) {
+    h.Clear()
+    for _, value := range elements {
+        h.Push(value)
+    }
+}
+
+// ToSlice returns the elements in pre-order of the tree (root first), in a new slice.
+func (h *PairingHeap[T]) ToSlice() []T {
+    values := make([]T, 0, h.size)
+    for value := range h.All() {
+        values = append(values, value)
+    }
+    return values
+}
+
+// Iterator returns an iterator over a snapshot of the elements, in pre-order of the tree.
+func (h *PairingHeap[T]) Iterator() iterator.Iterator[T] {
+    return newHeapIterator(h.ToSlice())
+}
+
+// SortedIterator Create an iterator that returns the elements in sorted order. Performs a copy of the data.
+func (h *PairingHeap[T]) SortedIterator() iterator.Iterator[T] {
+    return newHeapSortedIterator[T](h)
+}
+
+// All returns a sequence over the elements in pre-order of the tree: the root first, then every
+// child followed by its own subtree.
+func (h *PairingHeap[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if h.root == nil {
+            return
+        }
+        // Explicit stack, since both the depth and the sibling lists can be O(n) long
+        pending := []*pairingNode[T]{h.root}
+        for len(pending) > 0 {
+            node := pending[len(pending)-1]
+            pending = pending[:len(pending)-1]
+            if !yield(node.value) {
+                return
+            }
+            if node.sibling != nil {
+                pending = append(pending, node.sibling)
+            }
+            if node.child != nil {
+                pending = append(pending, node.child)
+            }
+        }
+    }
+}
+
+// Sorted returns a sequence in priority order, popping from a copy of the heap.
+func (h *PairingHeap[T]) Sorted() iter.Seq[T] {
+    return iterator.ToSeq(h.SortedIterator())
+}
+
+// Clone returns an ImplHeap with the same elements and ordering, as required by the Heap
+// interface.
+// Complexity: O(n)
+func (h *PairingHeap[T]) Clone() *ImplHeap[T] {
+    clone := &ImplHeap[T]{comparator: h.comparator, isMaxHeap: h.isMaxHeap}
+    clone.HeapifyInPlace(h.ToSlice())
+    return clone
+}
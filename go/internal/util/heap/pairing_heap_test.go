@@ -0,0 +1,58 @@
+package heap
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestPairingHeap_Meld tests that Meld moves all elements and empties the other heap
+func TestPairingHeap_Meld(t *testing.T) {
+    a := NewPairingMinHeap(compareInt)
+    a.Heapify([]int{5, 1, 9})
+    b := NewPairingMinHeap(compareInt)
+    b.Heapify([]int{4, 0, 7, 3})
+
+    a.Meld(b)
+    assert.Equal(t, 7, a.Size())
+    assert.True(t, b.IsEmpty())
+    _, ok := b.Peek()
+    assert.False(t, ok)
+
+    top, _ := a.Peek()
+    assert.Equal(t, 0, top)
+    assert.Equal(t, []int{0, 1, 3, 4, 5, 7, 9}, popAll(a))
+}
+
+// TestPairingHeap_MeldEdgeCases tests melding empty heaps, nil and the heap itself
+func TestPairingHeap_MeldEdgeCases(t *testing.T) {
+    a := NewPairingMaxHeap(compareInt)
+    a.Meld(NewPairingMaxHeap(compareInt))
+    assert.True(t, a.IsEmpty())
+
+    b := NewPairingMaxHeap(compareInt)
+    b.Push(3)
+    a.Meld(b)
+    a.Meld(nil)
+    a.Meld(a)
+    assert.Equal(t, 1, a.Size())
+
+    // The other heap is reusable after Meld
+    b.Push(10)
+    a.Meld(b)
+    assert.Equal(t, []int{10, 3}, popAll(a))
+}
+
+// TestPairingHeap_DeepTree tests traversal of a degenerate tree, deeper than a recursive walk would like
+func TestPairingHeap_DeepTree(t *testing.T) {
+    heap := NewPairingMinHeap(compareInt)
+    // Decreasing values make every push the new root, with the old root as its only child
+    for i := 100000; i > 0; i-- {
+        heap.Push(i)
+    }
+    count := 0
+    for range heap.All() {
+        count++
+    }
+    assert.Equal(t, 100000, count)
+}
@@ -0,0 +1,135 @@
+package heap
+
+import (
+    "iter"
+)
+
+// Sorting functions working directly on the caller's slice, with the same array layout as
+// ImplHeap but no wrapper: the slice itself is the heap.
+//
+// They all build a max-heap (for the comparator order) on a prefix of the slice, so its root is
+// the largest element of the prefix:
+//
+//   Sort:         heapify everything, then swap the root to the end and shrink, n times
+//   PartialSort:  keep the k smallest in a heap of size k, then sort that heap
+//   SelectKth:    keep the k+1 smallest in a heap of size k+1, the root is the answer
+
+// siftDownSlice moves data[index] down the max-heap data[:n]
+func siftDownSlice[T any](data []T, index, n int, cmp func(a, b T) int) {
+    for {
+        largest := index
+        if left := leftChild(index); left < n && cmp(data[left], data[largest]) > 0 {
+            largest = left
+        }
+        if right := rightChild(index); right < n && cmp(data[right], data[largest]) > 0 {
+            largest = right
+        }
+        if largest == index {
+            return
+        }
+        data[index], data[largest] = data[largest], data[index]
+        index = largest
+    }
+}
+
+// heapifySlice turns data[:n] into a max-heap, in O(n)
+func heapifySlice[T any](data []T, n int, cmp func(a, b T) int) {
+    for i := n/2 - 1; i >= 0; i-- {
+        siftDownSlice(data, i, n, cmp)
+    }
+}
+
+// sortHeap sorts the max-heap data[:n] in ascending order, moving the root to the end each time
+func sortHeap[T any](data []T, n int, cmp func(a, b T) int) {
+    for end := n - 1; end > 0; end-- {
+        data[0], data[end] = data[end], data[0]
+        siftDownSlice(data, 0, end, cmp)
+    }
+}
+
+// keepSmallest makes data[:k] a max-heap of the k smallest elements of data, swapping the
+// others to data[k:]. Requires 0 < k <= len(data).
+func keepSmallest[T any](data []T, k int, cmp func(a, b T) int) {
+    heapifySlice(data, k, cmp)
+    for i := k; i < len(data); i++ {
+        // Smaller than the largest kept element: swap them
+        if cmp(data[i], data[0]) < 0 {
+            data[0], data[i] = data[i], data[0]
+            siftDownSlice(data, 0, k, cmp)
+        }
+    }
+}
+
+// Sort sorts data in ascending order of cmp, in place, with heapsort.
+// The sort is not stable.
+//
+// Time complexity: O(n log n), with no allocation
+func Sort[T any](data []T, cmp func(a, b T) int) {
+    heapifySlice(data, len(data), cmp)
+    sortHeap(data, len(data), cmp)
+}
+
+// PartialSort reorders data so that data[:k] holds the k smallest elements in ascending order.
+// The order of data[k:] is unspecified. k is clamped to [0, len(data)].
+//
+// Time complexity: O(n log k), with no allocation
+func PartialSort[T any](data []T, k int, cmp func(a, b T) int) {
+    k = min(k, len(data))
+    if k <= 0 {
+        return
+    }
+    keepSmallest(data, k, cmp)
+    sortHeap(data, k, cmp)
+}
+
+// SelectKth returns the k-th smallest element of data (0-based: k = 0 is the minimum), reordering
+// data so that data[:k+1] holds the k+1 smallest elements, in no particular order.
+// Returns false if k is outside [0, len(data)).
+//
+// Time complexity: O(n log k), with no allocation
+func SelectKth[T any](data []T, k int, cmp func(a, b T) int) (T, bool) {
+    if k < 0 || k >= len(data) {
+        return *new(T), false
+    }
+    keepSmallest(data, k+1, cmp)
+    // The root is the largest of the k+1 smallest
+    return data[0], true
+}
+
+// NSmallest returns the k smallest elements of seq in ascending order, in O(min(k, n)) memory.
+// Fewer elements are returned if seq is shorter, so k may be far larger than the input.
+//
+// Time complexity: O(n log k)
+func NSmallest[T any](seq iter.Seq[T], k int, cmp func(a, b T) int) []T {
+    if k <= 0 {
+        return []T{}
+    }
+
+    // k is only an upper bound: start small and let append grow to the length of seq
+    kept := make([]T, 0, min(k, 64))
+    for value := range seq {
+        if len(kept) < k {
+            kept = append(kept, value)
+            if len(kept) == k {
+                heapifySlice(kept, k, cmp)
+            }
+        } else if cmp(value, kept[0]) < 0 {
+            kept[0] = value
+            siftDownSlice(kept, 0, k, cmp)
+        }
+    }
+
+    // A heap is only built once full: sort what was kept from scratch
+    Sort(kept, cmp)
+    return kept
+}
+
+// NLargest returns the k largest elements of seq in descending order, in O(min(k, n)) memory.
+// Fewer elements are returned if seq is shorter, so k may be far larger than the input.
+//
+// Time complexity: O(n log k)
+func NLargest[T any](seq iter.Seq[T], k int, cmp func(a, b T) int) []T {
+    return NSmallest(seq, k, func(a, b T) int {
+        return cmp(b, a)
+    })
+}
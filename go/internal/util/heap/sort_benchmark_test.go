@@ -0,0 +1,81 @@
+package heap
+
+import (
+    "slices"
+    "testing"
+)
+
+// Benchmarks comparing the heap sorting functions with slices.SortFunc.
+// Run with: go test -bench=Sort -benchmem ./internal/util/heap/
+
+const benchmarkSize = 100000
+
+// BenchmarkSort compares a full sort
+func BenchmarkSort(b *testing.B) {
+    values := randomValues(99, benchmarkSize)
+    data := make([]int, len(values))
+
+    b.Run("heap.Sort", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            Sort(data, compareInt)
+        }
+    })
+    b.Run("slices.SortFunc", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            slices.SortFunc(data, compareInt)
+        }
+    })
+}
+
+// BenchmarkPartialSort compares getting the 100 smallest elements, sorted
+func BenchmarkPartialSort(b *testing.B) {
+    const k = 100
+    values := randomValues(99, benchmarkSize)
+    data := make([]int, len(values))
+
+    b.Run("heap.PartialSort", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            PartialSort(data, k, compareInt)
+        }
+    })
+    b.Run("heap.NSmallest", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            NSmallest(slices.Values(values), k, compareInt)
+        }
+    })
+    b.Run("slices.SortFunc", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            slices.SortFunc(data, compareInt)
+        }
+    })
+}
+
+// BenchmarkSelectKth compares finding the median
+func BenchmarkSelectKth(b *testing.B) {
+    values := randomValues(99, benchmarkSize)
+    data := make([]int, len(values))
+
+    b.Run("heap.SelectKth", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            SelectKth(data, len(data)/2, compareInt)
+        }
+    })
+    b.Run("slices.SortFunc", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(data, values)
+            slices.SortFunc(data, compareInt)
+        }
+    })
+}
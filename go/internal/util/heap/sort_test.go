@@ -0,0 +1,88 @@
+package heap
+
+import (
+    "math"
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestSort tests heapsort against slices.Sort, including edge sizes
+func TestSort(t *testing.T) {
+    for _, n := range []int{0, 1, 2, 3, 10, 1000} {
+        values := randomValues(int64(n), max(n, 2))[:n]
+        expected := slices.Clone(values)
+        slices.Sort(expected)
+
+        Sort(values, compareInt)
+        assert.Equal(t, expected, values, "n = %d", n)
+    }
+
+    descending := []int{1, 5, 3}
+    Sort(descending, func(a, b int) int { return b - a })
+    assert.Equal(t, []int{5, 3, 1}, descending)
+}
+
+// TestPartialSort tests that the prefix holds the k smallest, sorted, and nothing is lost
+func TestPartialSort(t *testing.T) {
+    values := randomValues(21, 500)
+    expected := slices.Clone(values)
+    slices.Sort(expected)
+
+    for _, k := range []int{0, 1, 7, 499, 500} {
+        data := slices.Clone(values)
+        PartialSort(data, k, compareInt)
+        assert.Equal(t, expected[:k], data[:k], "k = %d", k)
+        assert.ElementsMatch(t, values, data, "PartialSort should only reorder")
+    }
+
+    // k larger than the slice sorts everything
+    data := []int{3, 1, 2}
+    PartialSort(data, 10, compareInt)
+    assert.Equal(t, []int{1, 2, 3}, data)
+    PartialSort(data, -1, compareInt)
+    assert.Equal(t, []int{1, 2, 3}, data)
+}
+
+// TestSelectKth tests selecting every rank
+func TestSelectKth(t *testing.T) {
+    values := randomValues(22, 200)
+    expected := slices.Clone(values)
+    slices.Sort(expected)
+
+    for k := range values {
+        data := slices.Clone(values)
+        value, ok := SelectKth(data, k, compareInt)
+        assert.True(t, ok)
+        assert.Equal(t, expected[k], value, "k = %d", k)
+        assert.ElementsMatch(t, expected[:k+1], data[:k+1], "the prefix should hold the k+1 smallest")
+    }
+
+    _, ok := SelectKth([]int{1, 2}, 2, compareInt)
+    assert.False(t, ok, "k out of range")
+    _, ok = SelectKth([]int{}, 0, compareInt)
+    assert.False(t, ok, "empty slice")
+}
+
+// TestNSmallestNLargest tests the streaming selections
+func TestNSmallestNLargest(t *testing.T) {
+    values := randomValues(23, 1000)
+    sorted := slices.Clone(values)
+    slices.Sort(sorted)
+
+    assert.Equal(t, sorted[:10], NSmallest(slices.Values(values), 10, compareInt))
+    largest := slices.Clone(sorted[len(sorted)-10:])
+    slices.Reverse(largest)
+    assert.Equal(t, largest, NLargest(slices.Values(values), 10, compareInt))
+
+    // Shorter sequences return everything, sorted
+    assert.Equal(t, []int{1, 2, 3}, NSmallest(slices.Values([]int{3, 1, 2}), 5, compareInt))
+    assert.Equal(t, []int{3, 2, 1}, NLargest(slices.Values([]int{3, 1, 2}), 5, compareInt))
+    assert.Empty(t, NSmallest(slices.Values(values), 0, compareInt))
+
+    // A huge k is only an upper bound, it must not be allocated up front
+    assert.Equal(t, []int{1, 2, 3}, NSmallest(slices.Values([]int{3, 1, 2}), math.MaxInt, compareInt))
+    assert.Equal(t, []int{3, 2, 1}, NLargest(slices.Values([]int{3, 1, 2}), math.MaxInt, compareInt))
+    assert.Equal(t, sorted[:100], NSmallest(slices.Values(values), 100, compareInt), "k above the initial capacity")
+}
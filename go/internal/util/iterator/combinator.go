@@ -0,0 +1,376 @@
+package iterator
+
+// Combinators build new iterators on top of existing ones.
+// All of them are lazy: nothing is pulled from the source until HasNext/Next is called on the
+// result, and at most the elements needed to answer that call are consumed. This allows chaining
+// them over a BinaryTree in-order iterator or a heap SortedIterator without materializing slices:
+//
+//	evens := Take(Filter(tree.Iterator(), isEven), 3)
+//	for evens.HasNext() {
+//		fmt.Println(evens.Next())
+//	}
+//
+// The source iterators are shared, not copied: advancing the source directly while a combinator
+// is in use will make the combinator skip those elements.
+
+// Pair holds two values, produced by Zip and Enumerate.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// -- Map --
+
+type mapIterator[T, U any] struct {
+	source Iterator[T]
+	mapper func(T) U
+}
+
+// Map returns an iterator that applies mapper to every element of source.
+func Map[T, U any](source Iterator[T], mapper func(T) U) Iterator[U] {
+	return &mapIterator[T, U]{source: source, mapper: mapper}
+}
+
+func (m *mapIterator[T, U]) HasNext() bool {
+	return m.source.HasNext()
+}
+
+func (m *mapIterator[T, U]) Next() U {
+	if !m.source.HasNext() {
+		panic(errExhausted)
+	}
+	return m.mapper(m.source.Next())
+}
+
+// -- Filter --
+
+// filterIterator needs one element of lookahead: HasNext can only answer after
+// finding the next element that matches the predicate.
+type filterIterator[T any] struct {
+	source    Iterator[T]
+	predicate func(T) bool
+	value     T
+	buffered  bool
+}
+
+// Filter returns an iterator over the elements of source for which predicate returns true.
+func Filter[T any](source Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &filterIterator[T]{source: source, predicate: predicate}
+}
+
+func (f *filterIterator[T]) HasNext() bool {
+	for !f.buffered && f.source.HasNext() {
+		value := f.source.Next()
+		if f.predicate(value) {
+			f.value, f.buffered = value, true
+		}
+	}
+	return f.buffered
+}
+
+func (f *filterIterator[T]) Next() T {
+	if !f.HasNext() {
+		panic(errExhausted)
+	}
+	value := f.value
+	f.value, f.buffered = *new(T), false
+	return value
+}
+
+// -- Take --
+
+type takeIterator[T any] struct {
+	source    Iterator[T]
+	remaining int
+}
+
+// Take returns an iterator over, at most, the first n elements of source.
+func Take[T any](source Iterator[T], n int) Iterator[T] {
+	return &takeIterator[T]{source: source, remaining: n}
+}
+
+func (t *takeIterator[T]) HasNext() bool {
+	return t.remaining > 0 && t.source.HasNext()
+}
+
+func (t *takeIterator[T]) Next() T {
+	if !t.HasNext() {
+		panic(errExhausted)
+	}
+	t.remaining--
+	return t.source.Next()
+}
+
+// -- Skip --
+
+type skipIterator[T any] struct {
+	source Iterator[T]
+	skip   int
+}
+
+// Skip returns an iterator over the elements of source after the first n.
+// The first n elements are discarded on the first call to HasNext or Next.
+func Skip[T any](source Iterator[T], n int) Iterator[T] {
+	return &skipIterator[T]{source: source, skip: n}
+}
+
+func (s *skipIterator[T]) HasNext() bool {
+	for ; s.skip > 0 && s.source.HasNext(); s.skip-- {
+		s.source.Next()
+	}
+	return s.source.HasNext()
+}
+
+func (s *skipIterator[T]) Next() T {
+	if !s.HasNext() {
+		panic(errExhausted)
+	}
+	return s.source.Next()
+}
+
+// -- TakeWhile --
+
+type takeWhileIterator[T any] struct {
+	source    Iterator[T]
+	predicate func(T) bool
+	value     T
+	buffered  bool
+	done      bool
+}
+
+// TakeWhile returns an iterator over the leading elements of source for which predicate returns true.
+// Note: the first element failing the predicate is consumed from source and discarded.
+func TakeWhile[T any](source Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &takeWhileIterator[T]{source: source, predicate: predicate}
+}
+
+func (t *takeWhileIterator[T]) HasNext() bool {
+	if t.buffered {
+		return true
+	}
+	if t.done || !t.source.HasNext() {
+		return false
+	}
+
+	value := t.source.Next()
+	if !t.predicate(value) {
+		t.done = true
+		return false
+	}
+	t.value, t.buffered = value, true
+	return true
+}
+
+func (t *takeWhileIterator[T]) Next() T {
+	if !t.HasNext() {
+		panic(errExhausted)
+	}
+	value := t.value
+	t.value, t.buffered = *new(T), false
+	return value
+}
+
+// -- Zip --
+
+type zipIterator[A, B any] struct {
+	first  Iterator[A]
+	second Iterator[B]
+}
+
+// Zip returns an iterator pairing the elements of both sources, stopping at the shorter one.
+func Zip[A, B any](first Iterator[A], second Iterator[B]) Iterator[Pair[A, B]] {
+	return &zipIterator[A, B]{first: first, second: second}
+}
+
+func (z *zipIterator[A, B]) HasNext() bool {
+	return z.first.HasNext() && z.second.HasNext()
+}
+
+func (z *zipIterator[A, B]) Next() Pair[A, B] {
+	if !z.HasNext() {
+		panic(errExhausted)
+	}
+	return Pair[A, B]{First: z.first.Next(), Second: z.second.Next()}
+}
+
+// -- Chain --
+
+type chainIterator[T any] struct {
+	sources []Iterator[T]
+}
+
+// Chain returns an iterator over all the elements of the first source, then the second, and so on.
+func Chain[T any](sources ...Iterator[T]) Iterator[T] {
+	return &chainIterator[T]{sources: sources}
+}
+
+func (c *chainIterator[T]) HasNext() bool {
+	// Drop the exhausted sources from the front
+	for len(c.sources) > 0 && !c.sources[0].HasNext() {
+		c.sources = c.sources[1:]
+	}
+	return len(c.sources) > 0
+}
+
+func (c *chainIterator[T]) Next() T {
+	if !c.HasNext() {
+		panic(errExhausted)
+	}
+	return c.sources[0].Next()
+}
+
+// -- Flatten --
+
+type flattenIterator[T any] struct {
+	source  Iterator[Iterator[T]]
+	current Iterator[T]
+}
+
+// Flatten returns an iterator over the elements of every iterator produced by source, in order.
+// Each inner iterator is only pulled from source once the previous one is exhausted.
+func Flatten[T any](source Iterator[Iterator[T]]) Iterator[T] {
+	return &flattenIterator[T]{source: source}
+}
+
+func (f *flattenIterator[T]) HasNext() bool {
+	for f.current == nil || !f.current.HasNext() {
+		if !f.source.HasNext() {
+			return false
+		}
+		f.current = f.source.Next()
+	}
+	return true
+}
+
+func (f *flattenIterator[T]) Next() T {
+	if !f.HasNext() {
+		panic(errExhausted)
+	}
+	return f.current.Next()
+}
+
+// -- Window --
+
+type windowIterator[T any] struct {
+	source Iterator[T]
+	size   int
+	window []T // last window returned, nil before the first one
+	ready  bool
+	done   bool
+}
+
+// Window returns an iterator over the sliding windows of size elements of source,
+// advancing one element at a time:
+//
+//	Window([1, 2, 3, 4], 2) -> [1, 2], [2, 3], [3, 4]
+//
+// Every window is a new slice, so callers may keep it. If source has fewer than size
+// elements there are no windows. Panics if size is not positive.
+func Window[T any](source Iterator[T], size int) Iterator[[]T] {
+	if size <= 0 {
+		panic("iterator: window size must be positive")
+	}
+	return &windowIterator[T]{source: source, size: size}
+}
+
+func (w *windowIterator[T]) HasNext() bool {
+	if w.ready {
+		return true
+	}
+	if w.done {
+		return false
+	}
+
+	if w.window == nil {
+		// First window: needs size elements
+		window := make([]T, 0, w.size)
+		for len(window) < w.size && w.source.HasNext() {
+			window = append(window, w.source.Next())
+		}
+		if len(window) < w.size {
+			w.done = true
+			return false
+		}
+		w.window, w.ready = window, true
+		return true
+	}
+
+	// Slide by one: drop the oldest element and append the next one
+	if !w.source.HasNext() {
+		w.done = true
+		return false
+	}
+	window := make([]T, w.size)
+	copy(window, w.window[1:])
+	window[w.size-1] = w.source.Next()
+	w.window, w.ready = window, true
+	return true
+}
+
+func (w *windowIterator[T]) Next() []T {
+	if !w.HasNext() {
+		panic(errExhausted)
+	}
+	w.ready = false
+	return w.window
+}
+
+// -- Chunk --
+
+type chunkIterator[T any] struct {
+	source Iterator[T]
+	size   int
+}
+
+// Chunk returns an iterator over consecutive, non-overlapping slices of size elements of source.
+// The last chunk may be shorter:
+//
+//	Chunk([1, 2, 3, 4, 5], 2) -> [1, 2], [3, 4], [5]
+//
+// Panics if size is not positive.
+func Chunk[T any](source Iterator[T], size int) Iterator[[]T] {
+	if size <= 0 {
+		panic("iterator: chunk size must be positive")
+	}
+	return &chunkIterator[T]{source: source, size: size}
+}
+
+func (c *chunkIterator[T]) HasNext() bool {
+	return c.source.HasNext()
+}
+
+func (c *chunkIterator[T]) Next() []T {
+	if !c.source.HasNext() {
+		panic(errExhausted)
+	}
+	chunk := make([]T, 0, c.size)
+	for len(chunk) < c.size && c.source.HasNext() {
+		chunk = append(chunk, c.source.Next())
+	}
+	return chunk
+}
+
+// -- Enumerate --
+
+type enumerateIterator[T any] struct {
+	source Iterator[T]
+	index  int
+}
+
+// Enumerate returns an iterator pairing every element of source with its position, starting at 0.
+func Enumerate[T any](source Iterator[T]) Iterator[Pair[int, T]] {
+	return &enumerateIterator[T]{source: source}
+}
+
+func (e *enumerateIterator[T]) HasNext() bool {
+	return e.source.HasNext()
+}
+
+func (e *enumerateIterator[T]) Next() Pair[int, T] {
+	if !e.source.HasNext() {
+		panic(errExhausted)
+	}
+	pair := Pair[int, T]{First: e.index, Second: e.source.Next()}
+	e.index++
+	return pair
+}
@@ -0,0 +1,141 @@
+package iterator
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingIterator records how many elements were pulled, to verify laziness
+type countingIterator[T any] struct {
+	Iterator[T]
+	pulled int
+}
+
+func (c *countingIterator[T]) Next() T {
+	c.pulled++
+	return c.Iterator.Next()
+}
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+// TestMap tests transforming every element
+func TestMap(t *testing.T) {
+	it := Map[int, string](newSliceIterator(1, 2, 3), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3"}, Collect(it))
+	assert.Panics(t, func() {
+		it.Next()
+	}, "Next should panic after iteration completes")
+}
+
+// TestFilter tests keeping only matching elements
+func TestFilter(t *testing.T) {
+	it := Filter[int](newSliceIterator(1, 2, 3, 4, 5, 6), isEven)
+
+	// HasNext must be idempotent
+	assert.True(t, it.HasNext())
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []int{2, 4, 6}, Collect(it))
+
+	assert.Empty(t, Collect(Filter[int](newSliceIterator(1, 3, 5), isEven)))
+}
+
+// TestTake tests limiting the number of elements
+func TestTake(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, Collect(Take[int](newSliceIterator(1, 2, 3), 2)))
+	assert.Equal(t, []int{1, 2, 3}, Collect(Take[int](newSliceIterator(1, 2, 3), 10)))
+	assert.Empty(t, Collect(Take[int](newSliceIterator(1, 2, 3), 0)))
+}
+
+// TestSkip tests discarding leading elements
+func TestSkip(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, Collect(Skip[int](newSliceIterator(1, 2, 3, 4), 2)))
+	assert.Empty(t, Collect(Skip[int](newSliceIterator(1, 2), 5)))
+	assert.Equal(t, []int{1, 2}, Collect(Skip[int](newSliceIterator(1, 2), 0)))
+}
+
+// TestTakeWhile tests stopping at the first mismatch
+func TestTakeWhile(t *testing.T) {
+	source := newSliceIterator(2, 4, 5, 6)
+	it := TakeWhile[int](source, isEven)
+	assert.Equal(t, []int{2, 4}, Collect(it))
+	assert.False(t, it.HasNext(), "TakeWhile should stay finished")
+
+	// The failing element is consumed, the rest is left in the source
+	assert.Equal(t, []int{6}, Collect[int](source))
+}
+
+// TestZip tests pairing two sources, stopping at the shorter one
+func TestZip(t *testing.T) {
+	it := Zip[int, string](newSliceIterator(1, 2, 3), newSliceIterator("a", "b"))
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, Collect(it))
+}
+
+// TestChain tests concatenating sources, including empty ones
+func TestChain(t *testing.T) {
+	it := Chain[int](newSliceIterator(1, 2), newSliceIterator[int](), newSliceIterator(3))
+	assert.Equal(t, []int{1, 2, 3}, Collect(it))
+	assert.Empty(t, Collect(Chain[int]()))
+}
+
+// TestFlatten tests concatenating nested iterators
+func TestFlatten(t *testing.T) {
+	nested := newSliceIterator[Iterator[int]](
+		newSliceIterator(1, 2),
+		newSliceIterator[int](),
+		newSliceIterator(3, 4),
+	)
+	assert.Equal(t, []int{1, 2, 3, 4}, Collect(Flatten[int](nested)))
+}
+
+// TestWindow tests sliding windows
+func TestWindow(t *testing.T) {
+	windows := Collect(Window[int](newSliceIterator(1, 2, 3, 4), 2))
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, windows)
+
+	// Windows must not share memory
+	windows[0][1] = 100
+	assert.Equal(t, 2, windows[1][0])
+
+	assert.Empty(t, Collect(Window[int](newSliceIterator(1, 2), 3)), "Not enough elements for a window")
+	assert.Equal(t, [][]int{{1, 2}}, Collect(Window[int](newSliceIterator(1, 2), 2)))
+	assert.Panics(t, func() {
+		Window[int](newSliceIterator(1), 0)
+	})
+}
+
+// TestChunk tests non-overlapping chunks
+func TestChunk(t *testing.T) {
+	chunks := Collect(Chunk[int](newSliceIterator(1, 2, 3, 4, 5), 2))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+	assert.Empty(t, Collect(Chunk[int](newSliceIterator[int](), 2)))
+	assert.Panics(t, func() {
+		Chunk[int](newSliceIterator(1), -1)
+	})
+}
+
+// TestEnumerate tests pairing elements with their position
+func TestEnumerate(t *testing.T) {
+	it := Enumerate[string](newSliceIterator("a", "b"))
+	assert.Equal(t, []Pair[int, string]{{0, "a"}, {1, "b"}}, Collect(it))
+}
+
+// TestCombinators_Lazy tests that chained combinators only pull what they need
+func TestCombinators_Lazy(t *testing.T) {
+	source := &countingIterator[int]{Iterator: newSliceIterator(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)}
+
+	it := Take(Map(Filter[int](source, isEven), func(v int) int { return v * 10 }), 2)
+	assert.Equal(t, 0, source.pulled, "Building the chain should not pull anything")
+
+	assert.Equal(t, 20, it.Next())
+	assert.Equal(t, 2, source.pulled)
+
+	assert.Equal(t, 40, it.Next())
+	assert.Equal(t, 4, source.pulled)
+
+	assert.False(t, it.HasNext())
+	assert.Equal(t, 4, source.pulled, "Take should stop pulling once satisfied")
+}
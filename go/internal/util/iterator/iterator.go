@@ -1,7 +1,29 @@
 package iterator
 
+import (
+	"fmt"
+
+	"interview_go/internal/util/errs"
+)
+
 // Iterator This is the common pattern used in Go when 'range' is not possible.
 type Iterator[T any] interface {
 	HasNext() bool
 	Next() T
 }
+
+// errExhausted is the panic value for calling Next() when HasNext() is false.
+var errExhausted = fmt.Errorf("iterator: %w", errs.ErrExhausted)
+
+// TryNext is the non-panicking variant of Next: it returns an error wrapping errs.ErrExhausted
+// when there are no more elements, instead of panicking.
+//
+//	for v, err := iterator.TryNext(it); err == nil; v, err = iterator.TryNext(it) {
+//		...
+//	}
+func TryNext[T any](it Iterator[T]) (T, error) {
+	if !it.HasNext() {
+		return *new(T), errExhausted
+	}
+	return it.Next(), nil
+}
@@ -0,0 +1,78 @@
+package iterator
+
+import "iter"
+
+// ToSeq bridges an Iterator into a Go 1.23 range-over-func sequence, so it can be used with
+// 'for v := range' and the stdlib helpers (slices.Collect, slices.Sorted, ...).
+//
+// The sequence consumes the iterator: ranging over it a second time yields nothing.
+// Breaking out of the loop leaves the remaining elements in the iterator.
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 is like ToSeq, but also yields the position (starting at 0) of every element.
+func ToSeq2[T any](it Iterator[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; it.HasNext(); i++ {
+			if !yield(i, it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq bridges a range-over-func sequence into an Iterator, so push-style code can be
+// consumed by functions still expecting HasNext/Next.
+//
+// It is built on iter.Pull: the sequence runs as a coroutine and is released as soon as it is
+// exhausted. An iterator abandoned before the end keeps the coroutine parked until it is
+// garbage collected.
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	next, stop := iter.Pull(seq)
+	return &seqIterator[T]{next: next, stop: stop}
+}
+
+// seqIterator holds one element of lookahead, since HasNext must answer without consuming.
+type seqIterator[T any] struct {
+	next     func() (T, bool)
+	stop     func()
+	value    T
+	buffered bool
+	done     bool
+}
+
+var _ Iterator[any] = (*seqIterator[any])(nil)
+
+func (s *seqIterator[T]) HasNext() bool {
+	if s.buffered {
+		return true
+	}
+	if s.done {
+		return false
+	}
+
+	value, ok := s.next()
+	if !ok {
+		s.done = true
+		s.stop()
+		return false
+	}
+	s.value, s.buffered = value, true
+	return true
+}
+
+func (s *seqIterator[T]) Next() T {
+	if !s.HasNext() {
+		panic(errExhausted)
+	}
+	value := s.value
+	s.value, s.buffered = *new(T), false
+	return value
+}
@@ -0,0 +1,107 @@
+package iterator
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"interview_go/internal/util/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSliceIterator is a test shortcut for FromSlice, returning the concrete type
+func newSliceIterator[T any](data ...T) *sliceIterator[T] {
+	return FromSlice(data).(*sliceIterator[T])
+}
+
+// TestToSeq tests ranging over an Iterator
+func TestToSeq(t *testing.T) {
+	var result []int
+	for v := range ToSeq[int](newSliceIterator(1, 2, 3)) {
+		result = append(result, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, result)
+
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(ToSeq[int](newSliceIterator(3, 1, 2))))
+	assert.Empty(t, slices.Collect(ToSeq[int](newSliceIterator[int]())))
+}
+
+// TestToSeq_Break tests that breaking out of the loop leaves the rest in the iterator
+func TestToSeq_Break(t *testing.T) {
+	it := newSliceIterator(1, 2, 3, 4)
+	for v := range ToSeq[int](it) {
+		if v == 2 {
+			break
+		}
+	}
+	assert.True(t, it.HasNext())
+	assert.Equal(t, 3, it.Next())
+}
+
+// TestToSeq2 tests that positions are yielded along with the values
+func TestToSeq2(t *testing.T) {
+	var indexes []int
+	var values []string
+	for i, v := range ToSeq2[string](newSliceIterator("a", "b", "c")) {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+// TestFromSeq tests pulling from a range-over-func sequence
+func TestFromSeq(t *testing.T) {
+	it := FromSeq(slices.Values([]int{10, 20, 30}))
+
+	// HasNext must be idempotent
+	assert.True(t, it.HasNext())
+	assert.True(t, it.HasNext())
+
+	var result []int
+	for it.HasNext() {
+		result = append(result, it.Next())
+	}
+	assert.Equal(t, []int{10, 20, 30}, result)
+	assert.False(t, it.HasNext())
+	assert.Panics(t, func() {
+		it.Next()
+	}, "Next should panic after iteration completes")
+}
+
+// TestFromSeq_Empty tests an empty sequence
+func TestFromSeq_Empty(t *testing.T) {
+	it := FromSeq(slices.Values([]int{}))
+	assert.False(t, it.HasNext())
+}
+
+// TestFromSeq_RoundTrip tests that both bridges compose
+func TestFromSeq_RoundTrip(t *testing.T) {
+	input := []string{"x", "y", "z"}
+	assert.Equal(t, input, slices.Collect(ToSeq(FromSeq(slices.Values(input)))))
+}
+
+// TestTryNext tests the non-panicking Next
+func TestTryNext(t *testing.T) {
+	it := newSliceIterator(1)
+
+	val, err := TryNext[int](it)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	_, err = TryNext[int](it)
+	assert.ErrorIs(t, err, errs.ErrExhausted)
+}
+
+// TestExhaustedPanicWrapsSentinel tests that the Next panic can be matched with errors.Is
+func TestExhaustedPanicWrapsSentinel(t *testing.T) {
+	it := Map[int, int](newSliceIterator[int](), func(v int) int { return v })
+
+	defer func() {
+		err, ok := recover().(error)
+		assert.True(t, ok, "Next should panic with an error")
+		assert.True(t, errors.Is(err, errs.ErrExhausted))
+	}()
+	it.Next()
+}
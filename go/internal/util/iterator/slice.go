@@ -0,0 +1,27 @@
+package iterator
+
+// FromSlice returns an iterator over the elements of data, in index order.
+// The slice is not copied: callers handing out a snapshot should pass a copy.
+func FromSlice[T any](data []T) Iterator[T] {
+	return &sliceIterator[T]{data: data}
+}
+
+type sliceIterator[T any] struct {
+	data  []T
+	index int
+}
+
+var _ Iterator[any] = (*sliceIterator[any])(nil)
+
+func (s *sliceIterator[T]) HasNext() bool {
+	return s.index < len(s.data)
+}
+
+func (s *sliceIterator[T]) Next() T {
+	if s.index >= len(s.data) {
+		panic(errExhausted)
+	}
+	value := s.data[s.index]
+	s.index++
+	return value
+}
@@ -0,0 +1,21 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFromSlice tests iterating over a slice in index order
+func TestFromSlice(t *testing.T) {
+	it := FromSlice([]string{"a", "b"})
+	assert.True(t, it.HasNext())
+	assert.Equal(t, "a", it.Next())
+	assert.Equal(t, "b", it.Next())
+	assert.False(t, it.HasNext())
+	assert.Panics(t, func() {
+		it.Next()
+	}, "Next should panic after iteration completes")
+
+	assert.False(t, FromSlice[int](nil).HasNext())
+}
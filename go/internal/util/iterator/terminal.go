@@ -0,0 +1,83 @@
+package iterator
+
+// Terminal operations consume the source iterator (fully, or until they know the answer)
+// and return a plain value.
+
+// Reduce folds every element of source into an accumulator, starting from initial.
+//
+//	sum := Reduce(it, 0, func(acc, v int) int { return acc + v })
+func Reduce[T, U any](source Iterator[T], initial U, reducer func(U, T) U) U {
+	accumulator := initial
+	for source.HasNext() {
+		accumulator = reducer(accumulator, source.Next())
+	}
+	return accumulator
+}
+
+// Collect drains source into a new slice.
+func Collect[T any](source Iterator[T]) []T {
+	result := make([]T, 0)
+	for source.HasNext() {
+		result = append(result, source.Next())
+	}
+	return result
+}
+
+// Count drains source and returns the number of elements.
+func Count[T any](source Iterator[T]) int {
+	count := 0
+	for source.HasNext() {
+		source.Next()
+		count++
+	}
+	return count
+}
+
+// Any returns true if predicate holds for at least one element.
+// It stops consuming source at the first match.
+func Any[T any](source Iterator[T], predicate func(T) bool) bool {
+	for source.HasNext() {
+		if predicate(source.Next()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if predicate holds for every element (true for an empty source).
+// It stops consuming source at the first mismatch.
+func All[T any](source Iterator[T], predicate func(T) bool) bool {
+	for source.HasNext() {
+		if !predicate(source.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Min returns the smallest element according to comparator, which follows the same
+// convention as the heap package: <0 if a<b, 0 if a==b, >0 if a>b.
+// On ties, the first element wins. Returns the zero value and false if source is empty.
+func Min[T any](source Iterator[T], comparator func(a, b T) int) (T, bool) {
+	return best(source, func(a, b T) bool { return comparator(a, b) < 0 })
+}
+
+// Max returns the largest element according to comparator.
+// On ties, the first element wins. Returns the zero value and false if source is empty.
+func Max[T any](source Iterator[T], comparator func(a, b T) int) (T, bool) {
+	return best(source, func(a, b T) bool { return comparator(a, b) > 0 })
+}
+
+// best keeps the element for which better(candidate, current) holds
+func best[T any](source Iterator[T], better func(a, b T) bool) (T, bool) {
+	if !source.HasNext() {
+		return *new(T), false
+	}
+	result := source.Next()
+	for source.HasNext() {
+		if value := source.Next(); better(value, result) {
+			result = value
+		}
+	}
+	return result, true
+}
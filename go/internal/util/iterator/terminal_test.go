@@ -0,0 +1,72 @@
+package iterator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func compareInt(a, b int) int {
+	return a - b
+}
+
+// TestReduce tests folding elements into an accumulator
+func TestReduce(t *testing.T) {
+	sum := Reduce(newSliceIterator(1, 2, 3, 4), 0, func(acc, v int) int { return acc + v })
+	assert.Equal(t, 10, sum)
+
+	joined := Reduce(newSliceIterator(1, 2), "", func(acc string, v int) string {
+		return acc + strings.Repeat("x", v)
+	})
+	assert.Equal(t, "xxx", joined)
+
+	assert.Equal(t, 7, Reduce(newSliceIterator[int](), 7, func(acc, v int) int { return acc + v }))
+}
+
+// TestCollect tests draining into a slice
+func TestCollect(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, Collect[int](newSliceIterator(1, 2)))
+	assert.NotNil(t, Collect[int](newSliceIterator[int]()), "Collect should return an empty, non-nil slice")
+}
+
+// TestCount tests counting elements
+func TestCount(t *testing.T) {
+	assert.Equal(t, 3, Count[int](newSliceIterator(1, 2, 3)))
+	assert.Equal(t, 0, Count[int](newSliceIterator[int]()))
+}
+
+// TestAnyAll tests the short-circuiting predicates
+func TestAnyAll(t *testing.T) {
+	assert.True(t, Any[int](newSliceIterator(1, 3, 4), isEven))
+	assert.False(t, Any[int](newSliceIterator(1, 3), isEven))
+	assert.False(t, Any[int](newSliceIterator[int](), isEven))
+
+	assert.True(t, All[int](newSliceIterator(2, 4), isEven))
+	assert.False(t, All[int](newSliceIterator(2, 3, 4), isEven))
+	assert.True(t, All[int](newSliceIterator[int](), isEven))
+
+	// Any stops at the first match
+	source := newSliceIterator(1, 2, 3)
+	Any[int](source, isEven)
+	assert.Equal(t, 3, source.Next())
+}
+
+// TestMinMax tests finding extremes with a comparator
+func TestMinMax(t *testing.T) {
+	minValue, ok := Min[int](newSliceIterator(5, 3, 8, 1, 9), compareInt)
+	assert.True(t, ok)
+	assert.Equal(t, 1, minValue)
+
+	maxValue, ok := Max[int](newSliceIterator(5, 3, 8, 1, 9), compareInt)
+	assert.True(t, ok)
+	assert.Equal(t, 9, maxValue)
+
+	_, ok = Min[int](newSliceIterator[int](), compareInt)
+	assert.False(t, ok, "Min on empty iterator should return false")
+
+	// On ties, the first element wins
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	longest, _ := Max[string](newSliceIterator("ab", "cd", "e"), byLength)
+	assert.Equal(t, "ab", longest)
+}
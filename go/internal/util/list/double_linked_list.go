@@ -1,7 +1,11 @@
 package list
 
 import (
+	"fmt"
+	"iter"
+
 	"interview_go/internal/util/collection"
+	"interview_go/internal/util/errs"
 	"interview_go/internal/util/iterator"
 )
 
@@ -24,9 +28,10 @@ func newNode[T any](value T) *node[T] {
 
 // DoubleLinkedList implements the List[T] interface. It implements generics T
 type DoubleLinkedList[T any] struct {
-	head *node[T] // Start of the list
-	tail *node[T] // End of the list
-	size int
+	head     *node[T] // Start of the list
+	tail     *node[T] // End of the list
+	size     int
+	modCount int // Structural modifications (add/remove), used by cursors to fail fast
 }
 
 // NewDoubleLinkedList is the idiomatic Go "constructor" to initialize the list.
@@ -46,16 +51,64 @@ func (d *DoubleLinkedList[T]) Add(value T) {
 		return
 	}
 
+	d.linkBetween(d.tail, nil, value)
+}
+
+// linkBetween creates a node for value between prior and next, which must be adjacent
+// (either may be nil at the ends of the list). Returns the new node.
+func (d *DoubleLinkedList[T]) linkBetween(prior, next *node[T], value T) *node[T] {
 	node := newNode[T](value)
-	if d.head == nil {
+	node.prior = prior
+	node.next = next
+	if prior == nil {
 		d.head = node
-		d.tail = node
 	} else {
-		node.prior = d.tail
-		d.tail.next = node
+		prior.next = node
+	}
+	if next == nil {
 		d.tail = node
+	} else {
+		next.prior = node
 	}
 	d.size++
+	d.modCount++
+	return node
+}
+
+// unlink detaches node from the list, fixing head and tail when needed.
+func (d *DoubleLinkedList[T]) unlink(node *node[T]) {
+	if node.prior == nil {
+		d.head = node.next
+	} else {
+		node.prior.next = node.next
+	}
+	if node.next == nil {
+		d.tail = node.prior
+	} else {
+		node.next.prior = node.prior
+	}
+	node.prior = nil
+	node.next = nil
+	d.size--
+	d.modCount++
+}
+
+// nodeAt returns the node at the given position, which must be valid.
+// It walks from whichever end is closer, so it takes at most size/2 steps.
+func (d *DoubleLinkedList[T]) nodeAt(index int) *node[T] {
+	if index < d.size/2 {
+		node := d.head
+		for i := 0; i < index; i++ {
+			node = node.next
+		}
+		return node
+	}
+
+	node := d.tail
+	for i := d.size - 1; i > index; i-- {
+		node = node.prior
+	}
+	return node
 }
 
 // Get the element from the nth position
@@ -63,12 +116,7 @@ func (d *DoubleLinkedList[T]) Get(index int) (T, bool) {
 	if index < 0 || index >= d.size {
 		return *new(T), false
 	}
-
-	node := d.head
-	for i := 0; i < index; i++ {
-		node = node.next
-	}
-	return node.value, true
+	return d.nodeAt(index).value, true
 }
 
 // GetFromEnd get the nth-element from the end
@@ -76,12 +124,33 @@ func (d *DoubleLinkedList[T]) GetFromEnd(index int) (T, bool) {
 	if index < 0 || index >= d.size {
 		return *new(T), false
 	}
+	return d.nodeAt(d.size - 1 - index).value, true
+}
 
-	node := d.tail
-	for i := 0; i < index; i++ {
-		node = node.prior
+// IndexOf returns the position of the first element equal to value, or -1 if there is none.
+// T is not required to be comparable, so the caller provides the equality function.
+func (d *DoubleLinkedList[T]) IndexOf(value T, equals func(a, b T) bool) int {
+	index := 0
+	for node := d.head; node != nil; node = node.next {
+		if equals(node.value, value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Contains checks if the list has an element equal to value.
+func (d *DoubleLinkedList[T]) Contains(value T, equals func(a, b T) bool) bool {
+	return d.IndexOf(value, equals) >= 0
+}
+
+// TryGet is like Get, but returns an error wrapping errs.ErrIndexOutOfRange for invalid positions.
+func (d *DoubleLinkedList[T]) TryGet(index int) (T, error) {
+	if index < 0 || index >= d.size {
+		return *new(T), fmt.Errorf("list: index %d with size %d: %w", index, d.size, errs.ErrIndexOutOfRange)
 	}
-	return node.value, true
+	return d.nodeAt(index).value, nil
 }
 
 // Size return the size of the list
@@ -94,6 +163,7 @@ func (d *DoubleLinkedList[T]) Clear() {
 	d.head = nil
 	d.tail = nil
 	d.size = 0
+	d.modCount++
 }
 
 func (d *DoubleLinkedList[T]) IsEmpty() bool {
@@ -138,18 +208,7 @@ func (d *DoubleLinkedList[T]) Remove(index int) bool {
 	if index < 0 || index >= d.size {
 		return false
 	}
-	if index == 0 {
-		// special case, delete head
-		d.head = d.head.next
-	} else {
-		node := d.head
-		for i := 0; i < index-1; i++ {
-			node = node.next
-		}
-		node.next.prior = node
-		node.next = node.next.next
-	}
-	d.size--
+	d.unlink(d.nodeAt(index))
 	return true
 }
 
@@ -158,16 +217,158 @@ func (d *DoubleLinkedList[T]) RemoveFromEnd() (T, bool) {
 		return *new(T), false
 	}
 	data := d.tail.value
-	d.tail = d.tail.prior
-	if d.tail != nil {
-		d.tail.next = nil
-	} else {
-		d.head = nil
+	d.unlink(d.tail)
+	return data, true
+}
+
+// AddFirst prepends an element to the start of the list (useful for Deque usage).
+func (d *DoubleLinkedList[T]) AddFirst(value T) {
+	if any(value) == nil {
+		return
 	}
-	d.size--
+	d.linkBetween(nil, d.head, value)
+}
+
+// RemoveFirst removes and returns the element at the start of the list.
+func (d *DoubleLinkedList[T]) RemoveFirst() (T, bool) {
+	if d.IsEmpty() {
+		return *new(T), false
+	}
+	data := d.head.value
+	d.unlink(d.head)
 	return data, true
 }
 
+// Insert adds an element at the given position, shifting the following elements.
+// The index may be equal to Size(), which appends the element.
+//
+// Example: Insert(1, X) into [A, B, C]
+//
+//	[A] ←→ [X] ←→ [B] ←→ [C]
+//
+// Time complexity: O(min(index, size-index))
+func (d *DoubleLinkedList[T]) Insert(index int, value T) bool {
+	if index < 0 || index > d.size {
+		return false
+	}
+	if any(value) == nil {
+		return false
+	}
+
+	if index == d.size {
+		d.linkBetween(d.tail, nil, value)
+	} else {
+		next := d.nodeAt(index)
+		d.linkBetween(next.prior, next, value)
+	}
+	return true
+}
+
+// Set replaces the element at the given position.
+// Like Add and Insert, a nil value is not stored: Set returns false and keeps the element.
+func (d *DoubleLinkedList[T]) Set(index int, value T) bool {
+	if index < 0 || index >= d.size {
+		return false
+	}
+	if any(value) == nil {
+		return false
+	}
+	d.nodeAt(index).value = value
+	return true
+}
+
+// RemoveIf deletes every element matching the predicate, in a single pass.
+// Returns the number of removed elements.
+func (d *DoubleLinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed := 0
+	for node := d.head; node != nil; {
+		next := node.next
+		if predicate(node.value) {
+			d.unlink(node)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// Reverse reverses the list in place, by swapping the prior and next pointers of every node.
+//
+// Before:
+//
+//	  head                 tail
+//	   ↓                    ↓
+//	  [A] ←→ [B] ←→ [C] ←→ [D]
+//
+// After:
+//
+//	  head                 tail
+//	   ↓                    ↓
+//	  [D] ←→ [C] ←→ [B] ←→ [A]
+//
+// Time complexity: O(n), no allocation
+func (d *DoubleLinkedList[T]) Reverse() {
+	for node := d.head; node != nil; node = node.prior {
+		// After the swap, the original next is stored in prior
+		node.prior, node.next = node.next, node.prior
+	}
+	d.head, d.tail = d.tail, d.head
+	d.modCount++
+}
+
+// Concat moves all elements of other to the end of this list, leaving other empty.
+// Only the boundary links change, so no node is copied.
+//
+// Time complexity: O(1)
+func (d *DoubleLinkedList[T]) Concat(other *DoubleLinkedList[T]) {
+	d.Splice(d.size, other)
+}
+
+// Splice moves all elements of other into this list, starting at the given position,
+// and leaves other empty. The index may be equal to Size(), which appends.
+//
+// Example: Splice(1, [X, Y]) into [A, B]
+//
+//	Before:  [A] ←→ [B]          [X] ←→ [Y]
+//	After:   [A] ←→ [X] ←→ [Y] ←→ [B]
+//
+// Time complexity: O(1) at either end, otherwise O(min(index, size-index)) to locate the position.
+func (d *DoubleLinkedList[T]) Splice(index int, other *DoubleLinkedList[T]) bool {
+	if index < 0 || index > d.size || other == d {
+		return false
+	}
+	if other == nil || other.IsEmpty() {
+		return true
+	}
+
+	var prior, next *node[T]
+	if index == d.size {
+		prior = d.tail
+	} else {
+		next = d.nodeAt(index)
+		prior = next.prior
+	}
+
+	// Link the boundaries of other between prior and next
+	other.head.prior = prior
+	if prior == nil {
+		d.head = other.head
+	} else {
+		prior.next = other.head
+	}
+	other.tail.next = next
+	if next == nil {
+		d.tail = other.tail
+	} else {
+		next.prior = other.tail
+	}
+	d.size += other.size
+	d.modCount++
+
+	other.Clear()
+	return true
+}
+
 func (d *DoubleLinkedList[T]) End() (T, bool) {
 	if d.IsEmpty() {
 		return *new(T), false
@@ -187,6 +388,41 @@ func (d *DoubleLinkedList[T]) Iterator() iterator.Iterator[T] {
 	return newDoubleLinkedListIterator(d.head)
 }
 
+// All returns a sequence over the values from head to tail, for use with range.
+func (d *DoubleLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := d.head; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed returns a sequence of (index, value) pairs from head to tail, like slices.All.
+func (d *DoubleLinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := d.head; node != nil; node = node.next {
+			if !yield(index, node.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Backward returns a sequence over the values from tail to head, following the prior pointers.
+func (d *DoubleLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := d.tail; node != nil; node = node.prior {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
 type doubleLinkedListIterator[T any] struct {
 	current *node[T]
 }
@@ -227,7 +463,7 @@ func (d *doubleLinkedListIterator[T]) HasNext() bool {
 //		             current
 func (d *doubleLinkedListIterator[T]) Next() T {
 	if d.current == nil {
-		panic("empty")
+		panic(errExhausted)
 	}
 	data := d.current.value
 	d.current = d.current.next
@@ -0,0 +1,145 @@
+package list
+
+// Cursor is a bidirectional, mutable iterator over a DoubleLinkedList.
+//
+// The cursor always sits in a gap between two elements (or before the head / after the tail).
+// Next() and Previous() jump over one element, returning it and making it the "current" element,
+// which Set() and Remove() then operate on.
+//
+//	        prior     next
+//	          ↓         ↓
+//	  [A] ←→ [B]   ^   [C] ←→ [D]
+//	               ↑
+//	             cursor
+//
+//	Next()         -> returns C, cursor moves between C and D, current = C
+//	Previous()     -> returns B, cursor moves between A and B, current = B
+//	InsertBefore(X) -> [B] ←→ [X] ^ [C]  (Previous() would return X)
+//	InsertAfter(X)  -> [B] ^ [X] ←→ [C]  (Next() would return X)
+//
+// All operations are O(1), since every node keeps its prior pointer.
+//
+// The cursor is fail-fast: if the list is structurally modified by anything other than this
+// cursor (Add, Remove, Clear, another cursor, ...), the next call on the cursor panics with an
+// error wrapping errs.ErrConcurrentModification, instead of silently walking detached nodes.
+type Cursor[T any] struct {
+	list             *DoubleLinkedList[T]
+	prior            *node[T] // Node before the gap, nil at the head
+	next             *node[T] // Node after the gap, nil at the tail
+	current          *node[T] // Last node returned by Next/Previous, nil if none (or removed)
+	expectedModCount int
+}
+
+// Compile-time check to ensure Cursor implements the ListIterator interface
+var _ ListIterator[any] = (*Cursor[any])(nil)
+
+// Cursor returns a cursor positioned before the first element.
+func (d *DoubleLinkedList[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: d, next: d.head, expectedModCount: d.modCount}
+}
+
+// CursorFromEnd returns a cursor positioned after the last element, ready to walk backwards.
+func (d *DoubleLinkedList[T]) CursorFromEnd() *Cursor[T] {
+	return &Cursor[T]{list: d, prior: d.tail, expectedModCount: d.modCount}
+}
+
+// checkModification panics if the list changed behind the cursor's back
+func (c *Cursor[T]) checkModification() {
+	if c.list.modCount != c.expectedModCount {
+		panic(errConcurrentModification)
+	}
+}
+
+// HasNext returns true if there is an element after the cursor.
+func (c *Cursor[T]) HasNext() bool {
+	c.checkModification()
+	return c.next != nil
+}
+
+// Next returns the element after the cursor and moves the cursor past it.
+// Panics with an error wrapping errs.ErrExhausted if there is no such element.
+func (c *Cursor[T]) Next() T {
+	c.checkModification()
+	if c.next == nil {
+		panic(errExhausted)
+	}
+	c.current = c.next
+	c.prior = c.next
+	c.next = c.next.next
+	return c.current.value
+}
+
+// HasPrevious returns true if there is an element before the cursor.
+func (c *Cursor[T]) HasPrevious() bool {
+	c.checkModification()
+	return c.prior != nil
+}
+
+// Previous returns the element before the cursor and moves the cursor back past it.
+// Panics with an error wrapping errs.ErrExhausted if there is no such element.
+func (c *Cursor[T]) Previous() T {
+	c.checkModification()
+	if c.prior == nil {
+		panic(errExhausted)
+	}
+	c.current = c.prior
+	c.next = c.prior
+	c.prior = c.prior.prior
+	return c.current.value
+}
+
+// Set replaces the value of the element last returned by Next or Previous.
+// Returns false if there is no such element (nothing returned yet, or it was removed),
+// or if value is nil, which the list never stores.
+func (c *Cursor[T]) Set(value T) bool {
+	c.checkModification()
+	if c.current == nil || any(value) == nil {
+		return false
+	}
+	c.current.value = value
+	return true
+}
+
+// Remove deletes the element last returned by Next or Previous, keeping the cursor in place.
+// Returns false if there is no such element (nothing returned yet, or it was already removed).
+func (c *Cursor[T]) Remove() bool {
+	c.checkModification()
+	if c.current == nil {
+		return false
+	}
+
+	// The cursor is adjacent to the current node, on one side or the other
+	if c.current == c.prior {
+		c.prior = c.current.prior
+	} else {
+		c.next = c.current.next
+	}
+	c.list.unlink(c.current)
+	c.current = nil
+	c.expectedModCount = c.list.modCount
+	return true
+}
+
+// InsertBefore inserts value in the gap, before the cursor: a following Previous() returns it,
+// while Next() is unaffected. Nil values are ignored, like in Add.
+func (c *Cursor[T]) InsertBefore(value T) {
+	c.checkModification()
+	if any(value) == nil {
+		return
+	}
+	c.prior = c.list.linkBetween(c.prior, c.next, value)
+	c.current = nil
+	c.expectedModCount = c.list.modCount
+}
+
+// InsertAfter inserts value in the gap, after the cursor: a following Next() returns it,
+// while Previous() is unaffected. Nil values are ignored, like in Add.
+func (c *Cursor[T]) InsertAfter(value T) {
+	c.checkModification()
+	if any(value) == nil {
+		return
+	}
+	c.next = c.list.linkBetween(c.prior, c.next, value)
+	c.current = nil
+	c.expectedModCount = c.list.modCount
+}
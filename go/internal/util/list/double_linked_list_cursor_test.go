@@ -0,0 +1,259 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func newListOf(values ...int) *DoubleLinkedList[int] {
+	list := NewDoubleLinkedList[int]()
+	for _, v := range values {
+		list.Add(v)
+	}
+	return list
+}
+
+// assertList checks the contents in both directions, so broken prior pointers are caught too.
+func assertList(t *testing.T, list *DoubleLinkedList[int], expected ...int) {
+	t.Helper()
+	if got := slices.Collect(list.All()); !slices.Equal(got, expected) {
+		t.Errorf("Forward expected %v, got %v", expected, got)
+	}
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+	if got := slices.Collect(list.Backward()); !slices.Equal(got, reversed) {
+		t.Errorf("Backward expected %v, got %v", reversed, got)
+	}
+	if list.Size() != len(expected) {
+		t.Errorf("Size expected %d, got %d", len(expected), list.Size())
+	}
+}
+
+func TestCursorTraversal(t *testing.T) {
+	list := newListOf(1, 2, 3)
+
+	t.Run("ForwardThenBackward", func(t *testing.T) {
+		cursor := list.Cursor()
+		if cursor.HasPrevious() {
+			t.Error("HasPrevious() should be false at the head")
+		}
+
+		var forward []int
+		for cursor.HasNext() {
+			forward = append(forward, cursor.Next())
+		}
+		if !slices.Equal(forward, []int{1, 2, 3}) {
+			t.Errorf("Forward expected [1 2 3], got %v", forward)
+		}
+
+		var backward []int
+		for cursor.HasPrevious() {
+			backward = append(backward, cursor.Previous())
+		}
+		if !slices.Equal(backward, []int{3, 2, 1}) {
+			t.Errorf("Backward expected [3 2 1], got %v", backward)
+		}
+	})
+
+	t.Run("FromEnd", func(t *testing.T) {
+		cursor := list.CursorFromEnd()
+		if cursor.HasNext() {
+			t.Error("HasNext() should be false at the tail")
+		}
+		if val := cursor.Previous(); val != 3 {
+			t.Errorf("Previous() expected 3, got %d", val)
+		}
+	})
+
+	t.Run("ZigZag", func(t *testing.T) {
+		cursor := list.Cursor()
+		cursor.Next()
+		cursor.Next()
+		// Next then Previous returns the same element
+		if val := cursor.Previous(); val != 2 {
+			t.Errorf("Previous() expected 2, got %d", val)
+		}
+		if val := cursor.Next(); val != 2 {
+			t.Errorf("Next() expected 2, got %d", val)
+		}
+	})
+
+	t.Run("PanicsAtTheEnds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Previous() at the head should panic")
+			}
+		}()
+		list.Cursor().Previous()
+	})
+}
+
+func TestCursorSet(t *testing.T) {
+	list := newListOf(1, 2, 3)
+	cursor := list.Cursor()
+
+	if cursor.Set(100) {
+		t.Error("Set() before Next() should return false")
+	}
+
+	cursor.Next()
+	cursor.Next()
+	if !cursor.Set(20) {
+		t.Error("Set() after Next() should return true")
+	}
+	assertList(t, list, 1, 20, 3)
+
+	anyList := NewDoubleLinkedList[any]()
+	anyList.Add(1)
+	anyCursor := anyList.Cursor()
+	anyCursor.Next()
+	if anyCursor.Set(nil) {
+		t.Error("Set() with a nil value should return false")
+	}
+	if value, _ := anyList.Get(0); value != 1 {
+		t.Errorf("Set() with a nil value should keep the element, got %v", value)
+	}
+}
+
+func TestCursorRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		walk     func(c *Cursor[int])
+		expected []int
+	}{
+		{"Head", func(c *Cursor[int]) { c.Next() }, []int{2, 3}},
+		{"Middle", func(c *Cursor[int]) { c.Next(); c.Next() }, []int{1, 3}},
+		{"Tail", func(c *Cursor[int]) { c.Next(); c.Next(); c.Next() }, []int{1, 2}},
+		{"AfterPrevious", func(c *Cursor[int]) { c.Next(); c.Next(); c.Previous() }, []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := newListOf(1, 2, 3)
+			cursor := list.Cursor()
+			tt.walk(cursor)
+
+			if !cursor.Remove() {
+				t.Fatal("Remove() should return true")
+			}
+			if cursor.Remove() {
+				t.Error("Second Remove() should return false")
+			}
+			assertList(t, list, tt.expected...)
+		})
+	}
+
+	t.Run("WhileIterating", func(t *testing.T) {
+		list := newListOf(1, 2, 3, 4, 5, 6)
+		cursor := list.Cursor()
+		for cursor.HasNext() {
+			if cursor.Next()%2 == 0 {
+				cursor.Remove()
+			}
+		}
+		assertList(t, list, 1, 3, 5)
+	})
+
+	t.Run("AllBackwards", func(t *testing.T) {
+		list := newListOf(1, 2, 3)
+		cursor := list.CursorFromEnd()
+		for cursor.HasPrevious() {
+			cursor.Previous()
+			cursor.Remove()
+		}
+		assertList(t, list)
+		if !list.IsEmpty() {
+			t.Error("List should be empty")
+		}
+	})
+}
+
+func TestCursorInsert(t *testing.T) {
+	t.Run("IntoEmptyList", func(t *testing.T) {
+		list := NewDoubleLinkedList[int]()
+		cursor := list.Cursor()
+		cursor.InsertAfter(2)
+		cursor.InsertBefore(1)
+		assertList(t, list, 1, 2)
+
+		if val := cursor.Next(); val != 2 {
+			t.Errorf("Next() expected 2, got %d", val)
+		}
+	})
+
+	t.Run("InTheMiddle", func(t *testing.T) {
+		list := newListOf(1, 4)
+		cursor := list.Cursor()
+		cursor.Next()
+
+		cursor.InsertBefore(2)
+		cursor.InsertAfter(3)
+		assertList(t, list, 1, 2, 3, 4)
+
+		if val := cursor.Previous(); val != 2 {
+			t.Errorf("Previous() expected 2, got %d", val)
+		}
+	})
+
+	t.Run("AtTheEnds", func(t *testing.T) {
+		list := newListOf(2)
+		list.Cursor().InsertBefore(1)
+		list.CursorFromEnd().InsertAfter(3)
+		assertList(t, list, 1, 2, 3)
+	})
+
+	t.Run("ResetsCurrent", func(t *testing.T) {
+		list := newListOf(1)
+		cursor := list.Cursor()
+		cursor.Next()
+		cursor.InsertAfter(2)
+		if cursor.Remove() {
+			t.Error("Remove() after an insert should return false")
+		}
+	})
+}
+
+func TestCursorFailFast(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(list *DoubleLinkedList[int])
+	}{
+		{"Add", func(list *DoubleLinkedList[int]) { list.Add(4) }},
+		{"Remove", func(list *DoubleLinkedList[int]) { list.Remove(0) }},
+		{"RemoveFromEnd", func(list *DoubleLinkedList[int]) { list.RemoveFromEnd() }},
+		{"Clear", func(list *DoubleLinkedList[int]) { list.Clear() }},
+		{"OtherCursor", func(list *DoubleLinkedList[int]) {
+			other := list.Cursor()
+			other.Next()
+			other.Remove()
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := newListOf(1, 2, 3)
+			cursor := list.Cursor()
+			cursor.Next()
+
+			tt.modify(list)
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Cursor should panic after an external modification")
+				}
+			}()
+			cursor.HasNext()
+		})
+	}
+
+	t.Run("OwnModificationsAreAllowed", func(t *testing.T) {
+		list := newListOf(1, 2, 3)
+		cursor := list.Cursor()
+		cursor.Next()
+		cursor.Remove()
+		cursor.InsertAfter(10)
+		cursor.Next()
+		cursor.Set(11) // Set is not structural
+		assertList(t, list, 11, 2, 3)
+	})
+}
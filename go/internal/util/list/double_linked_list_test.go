@@ -1,7 +1,11 @@
 package list
 
 import (
+	"errors"
+	"slices"
 	"testing"
+
+	"interview_go/internal/util/errs"
 	// Optional: You might use an assertion library like 'testify/assert' later,
 	// but for now, we stick to the standard library as it's the Go idiom.
 )
@@ -277,3 +281,309 @@ func TestClear(t *testing.T) {
 		t.Errorf("Size expected 0 after Clear(), got %d", list.Size())
 	}
 }
+
+// --- Range-over-func Tests ---
+
+func TestAll(t *testing.T) {
+	list := NewDoubleLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	t.Run("Forward", func(t *testing.T) {
+		if got := slices.Collect(list.All()); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("All() expected [1 2 3], got %v", got)
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		if got := slices.Collect(list.Backward()); !slices.Equal(got, []int{3, 2, 1}) {
+			t.Errorf("Backward() expected [3 2 1], got %v", got)
+		}
+	})
+
+	t.Run("Indexed", func(t *testing.T) {
+		for i, v := range list.Indexed() {
+			if expected, _ := list.Get(i); v != expected {
+				t.Errorf("Indexed() at %d expected %d, got %d", i, expected, v)
+			}
+		}
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		var got []int
+		for v := range list.All() {
+			if v == 2 {
+				break
+			}
+			got = append(got, v)
+		}
+		if !slices.Equal(got, []int{1}) {
+			t.Errorf("Expected [1] before break, got %v", got)
+		}
+	})
+
+	t.Run("OnEmptyList", func(t *testing.T) {
+		for range NewDoubleLinkedList[int]().All() {
+			t.Error("All() on empty list should not yield")
+		}
+	})
+}
+
+// TestRemoveKeepsLinks checks that Remove keeps the prior pointers and tail consistent.
+func TestRemoveKeepsLinks(t *testing.T) {
+	list := NewDoubleLinkedList[int]()
+	for _, v := range []int{1, 2, 3, 4} {
+		list.Add(v)
+	}
+
+	list.Remove(3) // tail
+	list.Remove(0) // head
+
+	if got := slices.Collect(list.Backward()); !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("Backward() expected [3 2], got %v", got)
+	}
+	if val, _ := list.End(); val != 3 {
+		t.Errorf("End expected 3, got %d", val)
+	}
+	if val, _ := list.RemoveFromEnd(); val != 3 {
+		t.Errorf("RemoveFromEnd expected 3, got %d", val)
+	}
+}
+
+// --- Positional API Tests ---
+
+func TestAddFirstRemoveFirst(t *testing.T) {
+	list := NewDoubleLinkedList[int]()
+	list.AddFirst(2)
+	list.AddFirst(1)
+	list.Add(3)
+	assertList(t, list, 1, 2, 3)
+
+	for _, expected := range []int{1, 2, 3} {
+		if val, ok := list.RemoveFirst(); !ok || val != expected {
+			t.Errorf("RemoveFirst() expected %d, got %d (ok=%v)", expected, val, ok)
+		}
+	}
+	if _, ok := list.RemoveFirst(); ok {
+		t.Error("RemoveFirst() on empty list should return false")
+	}
+	assertList(t, list)
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    int
+		ok       bool
+		expected []int
+	}{
+		{"AtHead", 0, true, []int{9, 1, 2, 3}},
+		{"InTheMiddle", 1, true, []int{1, 9, 2, 3}},
+		{"BeforeTail", 2, true, []int{1, 2, 9, 3}},
+		{"AtSize", 3, true, []int{1, 2, 3, 9}},
+		{"Negative", -1, false, []int{1, 2, 3}},
+		{"PastSize", 4, false, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := newListOf(1, 2, 3)
+			if ok := list.Insert(tt.index, 9); ok != tt.ok {
+				t.Errorf("Insert(%d) expected %v, got %v", tt.index, tt.ok, ok)
+			}
+			assertList(t, list, tt.expected...)
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	list := newListOf(1, 2, 3, 4, 5)
+	if !list.Set(0, 10) || !list.Set(4, 50) || !list.Set(3, 40) {
+		t.Error("Set() on valid indexes should return true")
+	}
+	if list.Set(5, 60) || list.Set(-1, 0) {
+		t.Error("Set() on invalid indexes should return false")
+	}
+	assertList(t, list, 10, 2, 3, 40, 50)
+}
+
+func TestSetNil(t *testing.T) {
+	list := NewDoubleLinkedList[any]()
+	list.Add(1)
+	if list.Set(0, nil) {
+		t.Error("Set() with a nil value should return false")
+	}
+	if value, _ := list.Get(0); value != 1 {
+		t.Errorf("Set() with a nil value should keep the element, got %v", value)
+	}
+}
+
+func TestGetWalksFromBothEnds(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4, 5, 6}
+	list := newListOf(values...)
+	for i, expected := range values {
+		if val, _ := list.Get(i); val != expected {
+			t.Errorf("Get(%d) expected %d, got %d", i, expected, val)
+		}
+		if val, _ := list.GetFromEnd(i); val != values[len(values)-1-i] {
+			t.Errorf("GetFromEnd(%d) expected %d, got %d", i, values[len(values)-1-i], val)
+		}
+	}
+	if _, ok := list.Get(7); ok {
+		t.Error("Get(7) should return false")
+	}
+}
+
+func TestIndexOfContains(t *testing.T) {
+	equals := func(a, b int) bool { return a == b }
+	list := newListOf(5, 7, 5)
+
+	if idx := list.IndexOf(5, equals); idx != 0 {
+		t.Errorf("IndexOf(5) expected 0, got %d", idx)
+	}
+	if idx := list.IndexOf(7, equals); idx != 1 {
+		t.Errorf("IndexOf(7) expected 1, got %d", idx)
+	}
+	if idx := list.IndexOf(8, equals); idx != -1 {
+		t.Errorf("IndexOf(8) expected -1, got %d", idx)
+	}
+	if !list.Contains(7, equals) || list.Contains(8, equals) {
+		t.Error("Contains() returned an unexpected result")
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []int
+		removed  int
+		expected []int
+	}{
+		{"Evens", []int{1, 2, 3, 4, 5, 6}, 3, []int{1, 3, 5}},
+		{"HeadAndTail", []int{2, 1, 4}, 2, []int{1}},
+		{"All", []int{2, 4}, 2, []int{}},
+		{"None", []int{1, 3}, 0, []int{1, 3}},
+		{"Empty", []int{}, 0, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := newListOf(tt.initial...)
+			if removed := list.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != tt.removed {
+				t.Errorf("RemoveIf() expected %d removed, got %d", tt.removed, removed)
+			}
+			assertList(t, list, tt.expected...)
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	list := newListOf(1, 2, 3, 4)
+	list.Reverse()
+	assertList(t, list, 4, 3, 2, 1)
+
+	single := newListOf(1)
+	single.Reverse()
+	assertList(t, single, 1)
+
+	empty := NewDoubleLinkedList[int]()
+	empty.Reverse()
+	assertList(t, empty)
+}
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []int
+		index    int
+		ok       bool
+		expected []int
+	}{
+		{"AtHead", []int{1, 2}, 0, true, []int{8, 9, 1, 2}},
+		{"InTheMiddle", []int{1, 2}, 1, true, []int{1, 8, 9, 2}},
+		{"AtEnd", []int{1, 2}, 2, true, []int{1, 2, 8, 9}},
+		{"IntoEmpty", []int{}, 0, true, []int{8, 9}},
+		{"InvalidIndex", []int{1, 2}, 3, false, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := newListOf(tt.initial...)
+			other := newListOf(8, 9)
+			if ok := list.Splice(tt.index, other); ok != tt.ok {
+				t.Errorf("Splice(%d) expected %v, got %v", tt.index, tt.ok, ok)
+			}
+			assertList(t, list, tt.expected...)
+			if tt.ok && !other.IsEmpty() {
+				t.Error("Other list should be empty after Splice")
+			}
+		})
+	}
+
+	t.Run("Itself", func(t *testing.T) {
+		list := newListOf(1, 2)
+		if list.Splice(0, list) {
+			t.Error("Splice() of a list into itself should return false")
+		}
+		assertList(t, list, 1, 2)
+	})
+}
+
+func TestConcat(t *testing.T) {
+	list := newListOf(1, 2)
+	other := newListOf(3, 4)
+	list.Concat(other)
+	assertList(t, list, 1, 2, 3, 4)
+	assertList(t, other)
+
+	// Both lists stay usable
+	other.Add(5)
+	list.Add(6)
+	assertList(t, list, 1, 2, 3, 4, 6)
+	assertList(t, other, 5)
+}
+
+// --- Error Tests ---
+
+func TestTryGet(t *testing.T) {
+	list := newListOf(1, 2)
+
+	if val, err := list.TryGet(1); err != nil || val != 2 {
+		t.Errorf("TryGet(1) expected 2, got %d (err=%v)", val, err)
+	}
+	for _, index := range []int{-1, 2} {
+		if _, err := list.TryGet(index); !errors.Is(err, errs.ErrIndexOutOfRange) {
+			t.Errorf("TryGet(%d) expected ErrIndexOutOfRange, got %v", index, err)
+		}
+	}
+}
+
+func TestIteratorPanicWrapsSentinel(t *testing.T) {
+	tests := []struct {
+		name     string
+		sentinel error
+		act      func()
+	}{
+		{"IteratorExhausted", errs.ErrExhausted, func() { NewDoubleLinkedList[int]().Iterator().Next() }},
+		{"CursorAtStart", errs.ErrExhausted, func() { newListOf(1).Cursor().Previous() }},
+		{"CursorModified", errs.ErrConcurrentModification, func() {
+			list := newListOf(1)
+			cursor := list.Cursor()
+			list.Add(2)
+			cursor.Next()
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, tt.sentinel) {
+					t.Errorf("Expected a panic wrapping %v, got %v", tt.sentinel, err)
+				}
+			}()
+			tt.act()
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package list
 
+import (
+	"fmt"
+
+	"interview_go/internal/util/errs"
+	"interview_go/internal/util/iterator"
+)
+
 // List is the generic interface that defines the contract for a linear collection (list).
 // T is a type parameter representing the type of elements in this list.
 // Only the mutator methods
@@ -7,6 +14,16 @@ type List[T any] interface {
 	// Add appends an element to the end of the list.
 	Add(value T)
 
+	// AddFirst prepends an element to the start of the list (useful for Deque usage).
+	AddFirst(value T)
+
+	// Insert adds an element at the specified position, shifting the following elements.
+	// The position may be equal to the size of the list, which appends the element.
+	Insert(index int, value T) bool
+
+	// Set replaces the element at the specified position.
+	Set(index int, value T) bool
+
 	// Clear removes all elements from the list.
 	Clear()
 
@@ -15,4 +32,45 @@ type List[T any] interface {
 
 	// RemoveFromEnd removes and returns the element at the end of the list (useful for Stack Pop).
 	RemoveFromEnd() (T, bool)
+
+	// RemoveFirst removes and returns the element at the start of the list (useful for Queue Poll).
+	RemoveFirst() (T, bool)
+
+	// RemoveIf deletes every element matching the predicate and returns how many were removed.
+	RemoveIf(predicate func(T) bool) int
+
+	// Reverse reverses the order of the elements in place.
+	Reverse()
+}
+
+// ListIterator is a bidirectional iterator that can also modify the list at its position.
+// Set and Remove act on the element last returned by Next or Previous.
+type ListIterator[T any] interface {
+	iterator.Iterator[T]
+
+	// HasPrevious returns true if there is an element before the current position.
+	HasPrevious() bool
+
+	// Previous returns the element before the current position and moves backwards.
+	Previous() T
+
+	// Set replaces the element last returned by Next or Previous.
+	Set(value T) bool
+
+	// Remove deletes the element last returned by Next or Previous.
+	Remove() bool
+
+	// InsertBefore inserts an element before the current position.
+	InsertBefore(value T)
+
+	// InsertAfter inserts an element after the current position.
+	InsertAfter(value T)
 }
+
+var (
+	// errExhausted is the panic value for moving an iterator or cursor past the end of the list
+	errExhausted = fmt.Errorf("list: %w", errs.ErrExhausted)
+
+	// errConcurrentModification is the panic value for a cursor whose list was modified behind its back
+	errConcurrentModification = fmt.Errorf("list: cursor: %w", errs.ErrConcurrentModification)
+)
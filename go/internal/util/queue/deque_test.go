@@ -0,0 +1,220 @@
+package queue
+
+import (
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// implementations runs the same tests against every Deque
+var implementations = []struct {
+    name    string
+    factory func() Deque[int]
+}{
+    {"LinkedList", func() Deque[int] { return NewLinkedListDeque[int]() }},
+    {"RingBuffer", func() Deque[int] { return NewRingBufferDeque[int](2) }},
+}
+
+func equalsInt(a, b int) bool {
+    return a == b
+}
+
+// TestDeque_NewIsEmpty checks the initial state of a new deque
+func TestDeque_NewIsEmpty(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            assert.True(t, deque.IsEmpty())
+            assert.Equal(t, 0, deque.Size())
+
+            _, ok := deque.Poll()
+            assert.False(t, ok, "Poll on empty deque should return false")
+            _, ok = deque.PollLast()
+            assert.False(t, ok, "PollLast on empty deque should return false")
+            _, ok = deque.PeekFirst()
+            assert.False(t, ok, "PeekFirst on empty deque should return false")
+            _, ok = deque.PeekLast()
+            assert.False(t, ok, "PeekLast on empty deque should return false")
+        })
+    }
+}
+
+// TestDeque_FIFO checks the Queue behavior (Offer/Poll)
+func TestDeque_FIFO(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            var queue Queue[int] = impl.factory()
+            for i := 1; i <= 5; i++ {
+                queue.Offer(i)
+            }
+
+            val, _ := queue.PeekFirst()
+            assert.Equal(t, 1, val)
+            assert.Equal(t, 5, queue.Size(), "PeekFirst should not remove")
+
+            for i := 1; i <= 5; i++ {
+                val, ok := queue.Poll()
+                assert.True(t, ok)
+                assert.Equal(t, i, val, "Queue should poll in FIFO order")
+            }
+            assert.True(t, queue.IsEmpty())
+        })
+    }
+}
+
+// TestDeque_LIFO checks the Stack behavior (OfferLast/PollLast)
+func TestDeque_LIFO(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            for i := 1; i <= 5; i++ {
+                deque.OfferLast(i)
+            }
+            for i := 5; i >= 1; i-- {
+                val, ok := deque.PollLast()
+                assert.True(t, ok)
+                assert.Equal(t, i, val, "PollLast should return in LIFO order")
+            }
+        })
+    }
+}
+
+// TestDeque_BothEnds checks interleaved operations at both ends
+func TestDeque_BothEnds(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            deque.OfferFirst(2)
+            deque.OfferLast(3)
+            deque.OfferFirst(1)
+            deque.OfferLast(4)
+            assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(deque.All()))
+
+            first, _ := deque.PeekFirst()
+            last, _ := deque.PeekLast()
+            assert.Equal(t, 1, first)
+            assert.Equal(t, 4, last)
+
+            val, _ := deque.PollFirst()
+            assert.Equal(t, 1, val)
+            val, _ = deque.PollLast()
+            assert.Equal(t, 4, val)
+            assert.Equal(t, []int{2, 3}, slices.Collect(deque.All()))
+        })
+    }
+}
+
+// TestDeque_Collection checks the read-only collection.Collection contract
+func TestDeque_Collection(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            for _, v := range []int{10, 20, 30} {
+                deque.Offer(v)
+            }
+
+            val, ok := deque.Get(1)
+            assert.True(t, ok)
+            assert.Equal(t, 20, val)
+            val, _ = deque.GetFromEnd(0)
+            assert.Equal(t, 30, val)
+            _, ok = deque.Get(3)
+            assert.False(t, ok)
+            _, ok = deque.GetFromEnd(-1)
+            assert.False(t, ok)
+
+            start, _ := deque.Start()
+            end, _ := deque.End()
+            assert.Equal(t, 10, start)
+            assert.Equal(t, 30, end)
+
+            assert.Equal(t, 2, deque.IndexOf(30, equalsInt))
+            assert.Equal(t, -1, deque.IndexOf(40, equalsInt))
+            assert.True(t, deque.Contains(10, equalsInt))
+
+            var result []int
+            for it := deque.Iterator(); it.HasNext(); {
+                result = append(result, it.Next())
+            }
+            assert.Equal(t, []int{10, 20, 30}, result)
+            assert.Equal(t, 3, deque.Size(), "Iterating should not remove elements")
+        })
+    }
+}
+
+// TestDeque_Clear checks that the deque is usable after Clear
+func TestDeque_Clear(t *testing.T) {
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            deque.Offer(1)
+            deque.Offer(2)
+            deque.Clear()
+            assert.True(t, deque.IsEmpty())
+
+            deque.Offer(3)
+            assert.Equal(t, []int{3}, slices.Collect(deque.All()))
+        })
+    }
+}
+
+// TestDeque_SlidingWindowMaximum uses the deque for the classic monotonic-queue problem
+func TestDeque_SlidingWindowMaximum(t *testing.T) {
+    nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+    k := 3
+    expected := []int{3, 3, 5, 5, 6, 7}
+
+    for _, impl := range implementations {
+        t.Run(impl.name, func(t *testing.T) {
+            // The deque keeps indexes of decreasing values
+            window := impl.factory()
+            var result []int
+            for i, num := range nums {
+                if first, ok := window.PeekFirst(); ok && first <= i-k {
+                    window.PollFirst()
+                }
+                for last, ok := window.PeekLast(); ok && nums[last] < num; last, ok = window.PeekLast() {
+                    window.PollLast()
+                }
+                window.OfferLast(i)
+                if i >= k-1 {
+                    first, _ := window.PeekFirst()
+                    result = append(result, nums[first])
+                }
+            }
+            assert.Equal(t, expected, result)
+        })
+    }
+}
+
+// TestDeque_IgnoresNil checks that every implementation drops nil values the same way
+func TestDeque_IgnoresNil(t *testing.T) {
+    factories := []struct {
+        name    string
+        factory func() Deque[any]
+    }{
+        {"LinkedList", func() Deque[any] { return NewLinkedListDeque[any]() }},
+        {"RingBuffer", func() Deque[any] { return NewRingBufferDeque[any](2) }},
+    }
+    for _, impl := range factories {
+        t.Run(impl.name, func(t *testing.T) {
+            deque := impl.factory()
+            deque.Offer(nil)
+            deque.OfferFirst(nil)
+            deque.OfferLast(nil)
+            assert.True(t, deque.IsEmpty())
+
+            deque.Offer(1)
+            deque.OfferFirst(nil)
+            deque.OfferLast(nil)
+            assert.Equal(t, 1, deque.Size())
+
+            first, ok := deque.PollFirst()
+            assert.True(t, ok)
+            assert.Equal(t, 1, first)
+            _, ok = deque.PollLast()
+            assert.False(t, ok, "nil values should not have been stored")
+        })
+    }
+}
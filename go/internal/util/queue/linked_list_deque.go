@@ -0,0 +1,109 @@
+package queue
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+    "interview_go/internal/util/list"
+)
+
+// LinkedListDeque implementation of a Deque using the DoubleLinkedList.
+// Every operation at the ends is O(1), at the cost of one node allocation per element.
+type LinkedListDeque[T any] struct {
+    // Our deque is actually a double-linked list, head first
+    list *list.DoubleLinkedList[T]
+}
+
+// NewLinkedListDeque Constructor
+func NewLinkedListDeque[T any]() *LinkedListDeque[T] {
+    return &LinkedListDeque[T]{
+        list: list.NewDoubleLinkedList[T](),
+    }
+}
+
+// NewLinkedListQueue Constructor, when only FIFO operations are needed
+func NewLinkedListQueue[T any]() Queue[T] {
+    return NewLinkedListDeque[T]()
+}
+
+var _ Deque[any] = (*LinkedListDeque[any])(nil)
+
+func (d *LinkedListDeque[T]) Offer(value T) {
+    d.list.Add(value)
+}
+
+func (d *LinkedListDeque[T]) Poll() (T, bool) {
+    return d.list.RemoveFirst()
+}
+
+func (d *LinkedListDeque[T]) OfferFirst(value T) {
+    d.list.AddFirst(value)
+}
+
+func (d *LinkedListDeque[T]) OfferLast(value T) {
+    d.list.Add(value)
+}
+
+func (d *LinkedListDeque[T]) PollFirst() (T, bool) {
+    return d.list.RemoveFirst()
+}
+
+func (d *LinkedListDeque[T]) PollLast() (T, bool) {
+    return d.list.RemoveFromEnd()
+}
+
+func (d *LinkedListDeque[T]) PeekFirst() (T, bool) {
+    return d.list.Start()
+}
+
+func (d *LinkedListDeque[T]) PeekLast() (T, bool) {
+    return d.list.End()
+}
+
+func (d *LinkedListDeque[T]) Clear() {
+    d.list.Clear()
+}
+
+// -- collection.Collection, delegated to the list --
+
+func (d *LinkedListDeque[T]) Get(index int) (T, bool) {
+    return d.list.Get(index)
+}
+
+func (d *LinkedListDeque[T]) GetFromEnd(index int) (T, bool) {
+    return d.list.GetFromEnd(index)
+}
+
+func (d *LinkedListDeque[T]) IndexOf(value T, equals func(a, b T) bool) int {
+    return d.list.IndexOf(value, equals)
+}
+
+func (d *LinkedListDeque[T]) Contains(value T, equals func(a, b T) bool) bool {
+    return d.list.Contains(value, equals)
+}
+
+func (d *LinkedListDeque[T]) Size() int {
+    return d.list.Size()
+}
+
+func (d *LinkedListDeque[T]) IsEmpty() bool {
+    return d.list.IsEmpty()
+}
+
+func (d *LinkedListDeque[T]) End() (T, bool) {
+    return d.list.End()
+}
+
+func (d *LinkedListDeque[T]) Start() (T, bool) {
+    return d.list.Start()
+}
+
+// Iterator traverses the deque from head to tail, without removing elements.
+func (d *LinkedListDeque[T]) Iterator() iterator.Iterator[T] {
+    return d.list.Iterator()
+}
+
+// All returns a sequence from head to tail, for use with range.
+func (d *LinkedListDeque[T]) All() iter.Seq[T] {
+    return d.list.All()
+}
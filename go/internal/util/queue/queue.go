@@ -0,0 +1,64 @@
+package queue
+
+import "interview_go/internal/util/collection"
+
+// Queue is a FIFO (first in, first out) collection: elements are offered at the tail
+// and polled from the head.
+//
+//     Offer(D)                      Poll() -> A
+//        ↓                              ↑
+//      tail                            head
+//       [D] → [C] → [B] → [A] → → → → →
+//
+// Typical usages are BFS, sliding windows and scheduling problems.
+// The read-only side follows the collection.Collection contract, where index 0 (Start)
+// is the head of the queue: the next element to be polled.
+type Queue[T any] interface {
+    collection.Collection[T]
+
+    // Offer adds an element to the tail of the queue.
+    // A nil value (T is an interface type holding nil) is ignored, as in the list package.
+    // Time complexity: O(1) (amortized for array-backed implementations)
+    Offer(value T)
+
+    // Poll removes and returns the element at the head of the queue.
+    // Returns the zero value and false if the queue is empty.
+    // Time complexity: O(1)
+    Poll() (T, bool)
+
+    // PeekFirst returns the element at the head of the queue without removing it.
+    // Time complexity: O(1)
+    PeekFirst() (T, bool)
+
+    // Clear removes all elements from the queue.
+    Clear()
+}
+
+// Deque is a double-ended queue: elements can be offered, polled and peeked at both ends.
+// It can be used as a Queue (Offer/Poll) or as a Stack (OfferLast/PollLast).
+//
+//     OfferFirst / PollFirst            OfferLast / PollLast
+//               ↓ ↑                            ↓ ↑
+//              head                           tail
+//               [A] ←→ [B] ←→ [C] ←→ [D]
+//
+// Offer is equivalent to OfferLast, and Poll to PollFirst.
+// Like Offer, OfferFirst and OfferLast ignore nil values.
+type Deque[T any] interface {
+    Queue[T]
+
+    // OfferFirst adds an element to the head of the deque.
+    OfferFirst(value T)
+
+    // OfferLast adds an element to the tail of the deque.
+    OfferLast(value T)
+
+    // PollFirst removes and returns the element at the head of the deque.
+    PollFirst() (T, bool)
+
+    // PollLast removes and returns the element at the tail of the deque.
+    PollLast() (T, bool)
+
+    // PeekLast returns the element at the tail of the deque without removing it.
+    PeekLast() (T, bool)
+}
@@ -0,0 +1,226 @@
+package queue
+
+import (
+    "fmt"
+    "iter"
+
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/iterator"
+)
+
+// defaultCapacity is the initial capacity of a RingBufferDeque when no hint is given
+const defaultCapacity = 8
+
+// RingBufferDeque is a Deque backed by a growable circular array.
+// The elements live in data[head], data[head+1], ... wrapping around the end of the slice.
+//
+// Example with capacity 8, head = 6 and size = 4:
+//
+//   index:   0     1     2     3     4     5     6     7
+//          [ C ] [ D ] [   ] [   ] [   ] [   ] [ A ] [ B ]
+//                   ↑                             ↑
+//                  tail                          head
+//
+// Logical order: A, B, C, D
+//
+// Position i of the deque is stored at data[(head + i) % len(data)].
+// When the array is full it doubles, unwrapping the elements at the start of the new array.
+//
+// Compared to LinkedListDeque there is no allocation per element and the memory is contiguous,
+// which makes it the better default for BFS and sliding-window problems.
+type RingBufferDeque[T any] struct {
+    data []T
+    head int // Index of the first element
+    size int
+}
+
+// NewRingBufferDeque creates an empty deque with room for capacity elements before growing.
+// A non-positive capacity uses the default.
+func NewRingBufferDeque[T any](capacity int) *RingBufferDeque[T] {
+    if capacity <= 0 {
+        capacity = defaultCapacity
+    }
+    return &RingBufferDeque[T]{data: make([]T, capacity)}
+}
+
+// NewRingBufferQueue creates an empty array-backed queue, when only FIFO operations are needed
+func NewRingBufferQueue[T any](capacity int) Queue[T] {
+    return NewRingBufferDeque[T](capacity)
+}
+
+var _ Deque[any] = (*RingBufferDeque[any])(nil)
+
+// physical maps a logical position (0 = head) to an index of the data array
+func (r *RingBufferDeque[T]) physical(index int) int {
+    return (r.head + index) % len(r.data)
+}
+
+// grow doubles the capacity, copying the elements in logical order to the start of the new array.
+//
+// Before (full):   [ C ] [ D ] [ A ] [ B ]    head = 2
+// After:           [ A ] [ B ] [ C ] [ D ] [   ] [   ] [   ] [   ]    head = 0
+//
+// O(n), amortized O(1) per Offer
+func (r *RingBufferDeque[T]) grow() {
+    data := make([]T, len(r.data)*2)
+    // First the run from head to the end of the array, then the wrapped part
+    n := copy(data, r.data[r.head:])
+    copy(data[n:], r.data[:r.head])
+    r.data = data
+    r.head = 0
+}
+
+func (r *RingBufferDeque[T]) Offer(value T) {
+    r.OfferLast(value)
+}
+
+func (r *RingBufferDeque[T]) Poll() (T, bool) {
+    return r.PollFirst()
+}
+
+func (r *RingBufferDeque[T]) OfferFirst(value T) {
+    // Nil values are ignored, like in LinkedListDeque
+    if any(value) == nil {
+        return
+    }
+    if r.size == len(r.data) {
+        r.grow()
+    }
+    // Move head one slot back, wrapping around to the end of the array
+    r.head = (r.head - 1 + len(r.data)) % len(r.data)
+    r.data[r.head] = value
+    r.size++
+}
+
+func (r *RingBufferDeque[T]) OfferLast(value T) {
+    if any(value) == nil {
+        return
+    }
+    if r.size == len(r.data) {
+        r.grow()
+    }
+    r.data[r.physical(r.size)] = value
+    r.size++
+}
+
+func (r *RingBufferDeque[T]) PollFirst() (T, bool) {
+    if r.size == 0 {
+        return *new(T), false
+    }
+    value := r.data[r.head]
+    // Release the slot, so the garbage collector can reclaim pointers
+    r.data[r.head] = *new(T)
+    r.head = r.physical(1)
+    r.size--
+    return value, true
+}
+
+func (r *RingBufferDeque[T]) PollLast() (T, bool) {
+    if r.size == 0 {
+        return *new(T), false
+    }
+    last := r.physical(r.size - 1)
+    value := r.data[last]
+    r.data[last] = *new(T)
+    r.size--
+    return value, true
+}
+
+func (r *RingBufferDeque[T]) PeekFirst() (T, bool) {
+    return r.Get(0)
+}
+
+func (r *RingBufferDeque[T]) PeekLast() (T, bool) {
+    return r.GetFromEnd(0)
+}
+
+// Clear removes all elements, keeping the capacity
+func (r *RingBufferDeque[T]) Clear() {
+    clear(r.data)
+    r.head = 0
+    r.size = 0
+}
+
+// Capacity returns the number of elements the deque can hold before growing.
+func (r *RingBufferDeque[T]) Capacity() int {
+    return len(r.data)
+}
+
+// -- collection.Collection --
+
+// Get retrieves the element at the given position from the head, in O(1).
+func (r *RingBufferDeque[T]) Get(index int) (T, bool) {
+    if index < 0 || index >= r.size {
+        return *new(T), false
+    }
+    return r.data[r.physical(index)], true
+}
+
+// GetFromEnd retrieves the element at the given position from the tail, in O(1).
+func (r *RingBufferDeque[T]) GetFromEnd(index int) (T, bool) {
+    return r.Get(r.size - 1 - index)
+}
+
+func (r *RingBufferDeque[T]) IndexOf(value T, equals func(a, b T) bool) int {
+    for i := 0; i < r.size; i++ {
+        if equals(r.data[r.physical(i)], value) {
+            return i
+        }
+    }
+    return -1
+}
+
+func (r *RingBufferDeque[T]) Contains(value T, equals func(a, b T) bool) bool {
+    return r.IndexOf(value, equals) >= 0
+}
+
+func (r *RingBufferDeque[T]) Size() int {
+    return r.size
+}
+
+func (r *RingBufferDeque[T]) IsEmpty() bool {
+    return r.size == 0
+}
+
+func (r *RingBufferDeque[T]) End() (T, bool) {
+    return r.PeekLast()
+}
+
+func (r *RingBufferDeque[T]) Start() (T, bool) {
+    return r.PeekFirst()
+}
+
+// Iterator traverses the deque from head to tail, without removing elements.
+func (r *RingBufferDeque[T]) Iterator() iterator.Iterator[T] {
+    return &ringBufferIterator[T]{deque: r}
+}
+
+// All returns a sequence from head to tail, for use with range.
+func (r *RingBufferDeque[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for i := 0; i < r.size; i++ {
+            if !yield(r.data[r.physical(i)]) {
+                return
+            }
+        }
+    }
+}
+
+// ringBufferIterator walks the logical positions of the deque
+type ringBufferIterator[T any] struct {
+    deque *RingBufferDeque[T]
+    index int
+}
+
+func (it *ringBufferIterator[T]) HasNext() bool {
+    return it.index < it.deque.size
+}
+
+func (it *ringBufferIterator[T]) Next() T {
+    value, ok := it.deque.Get(it.index)
+    if !ok {
+        panic(fmt.Errorf("queue: %w", errs.ErrExhausted))
+    }
+    it.index++
+    return value
+}
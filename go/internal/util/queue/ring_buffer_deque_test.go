@@ -0,0 +1,78 @@
+package queue
+
+import (
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestRingBufferDeque_DefaultCapacity tests the capacity hint
+func TestRingBufferDeque_DefaultCapacity(t *testing.T) {
+    assert.Equal(t, defaultCapacity, NewRingBufferDeque[int](0).Capacity())
+    assert.Equal(t, defaultCapacity, NewRingBufferDeque[int](-5).Capacity())
+    assert.Equal(t, 100, NewRingBufferDeque[int](100).Capacity())
+}
+
+// TestRingBufferDeque_WrapAround tests that the head wraps around the end of the array
+func TestRingBufferDeque_WrapAround(t *testing.T) {
+    deque := NewRingBufferDeque[int](4)
+
+    // Move the head to the middle of the array
+    deque.Offer(0)
+    deque.Offer(0)
+    deque.Poll()
+    deque.Poll()
+
+    // Fill it, wrapping around
+    for i := 1; i <= 4; i++ {
+        deque.Offer(i)
+    }
+    assert.Equal(t, 4, deque.Capacity(), "Should not grow while there is room")
+    assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(deque.All()))
+
+    // OfferFirst from head 0 wraps to the end
+    deque = NewRingBufferDeque[int](4)
+    deque.OfferFirst(2)
+    deque.OfferFirst(1)
+    deque.OfferLast(3)
+    assert.Equal(t, []int{1, 2, 3}, slices.Collect(deque.All()))
+    val, _ := deque.PollLast()
+    assert.Equal(t, 3, val)
+}
+
+// TestRingBufferDeque_Grow tests that growing keeps the logical order of a wrapped buffer
+func TestRingBufferDeque_Grow(t *testing.T) {
+    deque := NewRingBufferDeque[int](4)
+    deque.Offer(3)
+    deque.Offer(4)
+    deque.OfferFirst(2)
+    deque.OfferFirst(1) // Full and wrapped: [3, 4, 1, 2], head = 2
+
+    deque.Offer(5)
+    assert.Equal(t, 8, deque.Capacity(), "Should double when full")
+    assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(deque.All()))
+
+    for i := 6; i <= 100; i++ {
+        deque.Offer(i)
+    }
+    for i := 1; i <= 100; i++ {
+        val, ok := deque.Poll()
+        assert.True(t, ok)
+        assert.Equal(t, i, val)
+    }
+}
+
+// TestRingBufferDeque_ReleasesSlots tests that polled slots are zeroed, so pointers can be collected
+func TestRingBufferDeque_ReleasesSlots(t *testing.T) {
+    deque := NewRingBufferDeque[*int](2)
+    a, b := 1, 2
+    deque.Offer(&a)
+    deque.Offer(&b)
+    deque.Poll()
+    deque.PollLast()
+
+    for _, slot := range deque.data {
+        assert.Nil(t, slot)
+    }
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+    "sync"
+    "time"
+)
+
+// Clock is the source of time of a Scheduler. Production code uses RealClock; tests use a
+// FakeClock and advance it by hand, so timers fire deterministically without sleeping.
+type Clock interface {
+    // Now returns the current time.
+    Now() time.Time
+
+    // After returns a channel that receives the current time once d has elapsed.
+    // A non-positive d fires immediately.
+    After(d time.Duration) <-chan time.Time
+}
+
+// RealClock is the wall clock, backed by the time package.
+type RealClock struct{}
+
+func (RealClock) Now() time.Time {
+    return time.Now()
+}
+
+func (RealClock) After(d time.Duration) <-chan time.Time {
+    return time.After(d)
+}
+
+// fakeWaiter is a channel returned by FakeClock.After, waiting for its deadline
+type fakeWaiter struct {
+    deadline time.Time
+    ch       chan time.Time
+}
+
+// FakeClock is a Clock that only moves when told to, with Advance or Set.
+// It is safe for concurrent use.
+type FakeClock struct {
+    mu      sync.Mutex
+    now     time.Time
+    waiters []fakeWaiter
+}
+
+// NewFakeClock creates a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+    return &FakeClock{now: start}
+}
+
+func (f *FakeClock) Now() time.Time {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return f.now
+}
+
+func (f *FakeClock) After(d time.Duration) <-chan time.Time {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+
+    // Buffered, so firing never blocks on a receiver that went away
+    ch := make(chan time.Time, 1)
+    if d <= 0 {
+        ch <- f.now
+        return ch
+    }
+    f.waiters = append(f.waiters, fakeWaiter{deadline: f.now.Add(d), ch: ch})
+    return ch
+}
+
+// Advance moves the clock forward by d, firing the After channels whose deadline was reached.
+func (f *FakeClock) Advance(d time.Duration) {
+    f.mu.Lock()
+    now := f.now.Add(d)
+    f.mu.Unlock()
+    f.Set(now)
+}
+
+// Set moves the clock to t, firing the After channels whose deadline was reached.
+// Moving backwards is allowed, and fires nothing.
+func (f *FakeClock) Set(t time.Time) {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+
+    f.now = t
+    pending := f.waiters[:0]
+    for _, waiter := range f.waiters {
+        if waiter.deadline.After(t) {
+            pending = append(pending, waiter)
+        } else {
+            waiter.ch <- t
+        }
+    }
+    clear(f.waiters[len(pending):])
+    f.waiters = pending
+}
+
+// Waiters returns the number of After channels not fired yet. Tests use it to wait until a
+// goroutine is blocked on the clock before advancing it.
+func (f *FakeClock) Waiters() int {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return len(f.waiters)
+}
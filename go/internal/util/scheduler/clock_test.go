@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fired returns true if ch received a value, without blocking
+func fired(ch <-chan time.Time) bool {
+    select {
+    case <-ch:
+        return true
+    default:
+        return false
+    }
+}
+
+func TestFakeClock_Advance(t *testing.T) {
+    clock := NewFakeClock(epoch)
+    assert.Equal(t, epoch, clock.Now())
+
+    short := clock.After(time.Second)
+    long := clock.After(time.Minute)
+    assert.Equal(t, 2, clock.Waiters())
+
+    clock.Advance(999 * time.Millisecond)
+    assert.False(t, fired(short))
+
+    clock.Advance(time.Millisecond)
+    assert.True(t, fired(short))
+    assert.False(t, fired(long))
+    assert.Equal(t, 1, clock.Waiters())
+    assert.Equal(t, epoch.Add(time.Second), clock.Now())
+
+    clock.Set(epoch.Add(time.Hour))
+    assert.True(t, fired(long))
+    assert.Equal(t, 0, clock.Waiters())
+}
+
+func TestFakeClock_AfterNonPositive(t *testing.T) {
+    clock := NewFakeClock(epoch)
+    assert.True(t, fired(clock.After(0)))
+    assert.True(t, fired(clock.After(-time.Second)))
+    assert.Equal(t, 0, clock.Waiters())
+}
+
+func TestFakeClock_SetBackwards(t *testing.T) {
+    clock := NewFakeClock(epoch)
+    ch := clock.After(time.Second)
+
+    clock.Set(epoch.Add(-time.Hour))
+    assert.False(t, fired(ch))
+    assert.Equal(t, epoch.Add(-time.Hour), clock.Now())
+}
+
+func TestRealClock(t *testing.T) {
+    clock := RealClock{}
+    before := time.Now()
+    assert.False(t, clock.Now().Before(before))
+
+    select {
+    case <-clock.After(time.Millisecond):
+    case <-time.After(time.Second):
+        t.Fatal("RealClock.After did not fire")
+    }
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+    "time"
+
+    "interview_go/internal/util/heap"
+)
+
+// heapBackend keeps the timers in an indexed min-heap ordered by deadline. The index by id lets
+// Cancel remove a timer in O(log n), instead of leaving it in the heap until its deadline.
+type heapBackend struct {
+    timers *heap.IndexedHeap[uint64, *timer]
+}
+
+func newHeapBackend() *heapBackend {
+    return &heapBackend{
+        timers: heap.NewIndexedMinHeap(func(t *timer) uint64 { return t.id }, compareTimers),
+    }
+}
+
+func (b *heapBackend) add(t *timer) {
+    b.timers.Push(t)
+}
+
+func (b *heapBackend) remove(t *timer) bool {
+    _, ok := b.timers.Remove(t.id)
+    return ok
+}
+
+func (b *heapBackend) due(now time.Time) []*timer {
+    var due []*timer
+    for {
+        top, ok := b.timers.Peek()
+        if !ok || top.deadline.After(now) {
+            return due
+        }
+        b.timers.Pop()
+        due = append(due, top)
+    }
+}
+
+func (b *heapBackend) next() (time.Time, bool) {
+    top, ok := b.timers.Peek()
+    if !ok {
+        return time.Time{}, false
+    }
+    return top.deadline, true
+}
+
+func (b *heapBackend) size() int {
+    return b.timers.Size()
+}
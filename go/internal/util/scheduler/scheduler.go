@@ -0,0 +1,253 @@
+// Package scheduler runs func() tasks at a deadline, after a delay, or on a fixed interval.
+//
+//   s := scheduler.New(scheduler.RealClock{})
+//   go s.Run(ctx)
+//
+//   retry := s.After(2*time.Second, func() { ... })
+//   s.Every(time.Minute, flushMetrics)
+//   retry.Cancel()
+//
+// Two backends keep the pending timers:
+//
+//   - New: a priority queue (heap.IndexedHeap) ordered by deadline. Exact deadlines,
+//     O(log n) schedule and cancel.
+//   - NewTimingWheel: a hierarchical timing wheel with a fixed tick. O(1) schedule and cancel,
+//     for very large numbers of timers (connection timeouts, retries), at the cost of firing up
+//     to one tick late.
+//
+// Time comes from a Clock, so tests can use a FakeClock and call RunDue (or let Run react)
+// after advancing it, without sleeping.
+package scheduler
+
+import (
+    "context"
+    "sync"
+    "time"
+)
+
+// timer is a scheduled task
+type timer struct {
+    id       uint64 // Increasing: orders timers with the same deadline by scheduling order
+    deadline time.Time
+    interval time.Duration // Zero for one-shot timers
+    task     func()
+    done     bool // Fired (one-shot) or cancelled: no run in the future
+
+    // Timing wheel bookkeeping
+    tick  int64 // Tick at which the timer expires
+    level int
+    slot  int
+}
+
+// compareTimers orders timers by deadline, then by scheduling order
+func compareTimers(a, b *timer) int {
+    if c := a.deadline.Compare(b.deadline); c != 0 {
+        return c
+    }
+    switch {
+    case a.id < b.id:
+        return -1
+    case a.id > b.id:
+        return 1
+    }
+    return 0
+}
+
+// backend stores the pending timers
+type backend interface {
+    // add stores a timer
+    add(t *timer)
+    // remove deletes a pending timer, returning false if it was not stored
+    remove(t *timer) bool
+    // due removes and returns the timers that are due at now, ordered by compareTimers
+    due(now time.Time) []*timer
+    // next returns when due should be called next, false if there are no timers
+    next() (time.Time, bool)
+    // size returns the number of pending timers
+    size() int
+}
+
+// Scheduler runs tasks when their deadline is reached. It is safe for concurrent use: tasks can
+// be scheduled and cancelled from any goroutine, including from inside a running task.
+//
+// Tasks run on the goroutine calling RunDue (or Run), one after the other, in deadline order.
+// A slow task delays the following ones: hand long work over to another goroutine.
+type Scheduler struct {
+    mu     sync.Mutex
+    clock  Clock
+    timers backend
+    nextID uint64
+    wake   chan struct{} // Signals Run that an earlier timer may have been added
+}
+
+// New creates a Scheduler keeping its timers in a priority queue ordered by deadline.
+// A nil clock uses RealClock.
+func New(clock Clock) *Scheduler {
+    return newScheduler(clock, newHeapBackend())
+}
+
+// NewTimingWheel creates a Scheduler keeping its timers in a hierarchical timing wheel.
+// Deadlines are rounded up to tick, so timers fire up to one tick late (never early).
+// A nil clock uses RealClock. Panics if tick is not positive.
+func NewTimingWheel(clock Clock, tick time.Duration) *Scheduler {
+    if tick <= 0 {
+        panic("scheduler: tick must be positive")
+    }
+    if clock == nil {
+        clock = RealClock{}
+    }
+    return newScheduler(clock, newTimingWheel(clock.Now(), tick))
+}
+
+func newScheduler(clock Clock, timers backend) *Scheduler {
+    if clock == nil {
+        clock = RealClock{}
+    }
+    return &Scheduler{clock: clock, timers: timers, wake: make(chan struct{}, 1)}
+}
+
+// schedule stores a new timer and wakes Run up
+func (s *Scheduler) schedule(deadline time.Time, interval time.Duration, task func()) *Handle {
+    s.mu.Lock()
+    s.nextID++
+    t := &timer{id: s.nextID, deadline: deadline, interval: interval, task: task}
+    s.timers.add(t)
+    s.mu.Unlock()
+
+    select {
+    case s.wake <- struct{}{}:
+    default:
+        // Run is already due to wake up
+    }
+    return &Handle{scheduler: s, timer: t}
+}
+
+// At schedules task to run once at deadline. A deadline in the past runs on the next RunDue.
+func (s *Scheduler) At(deadline time.Time, task func()) *Handle {
+    return s.schedule(deadline, 0, task)
+}
+
+// After schedules task to run once, delay from now.
+func (s *Scheduler) After(delay time.Duration, task func()) *Handle {
+    return s.schedule(s.clock.Now().Add(delay), 0, task)
+}
+
+// Every schedules task to run every interval, starting interval from now.
+// Runs are at fixed rate: if RunDue is called late, the missed runs are skipped, not caught up,
+// and the next run stays aligned on the original schedule. Panics if interval is not positive.
+func (s *Scheduler) Every(interval time.Duration, task func()) *Handle {
+    if interval <= 0 {
+        panic("scheduler: interval must be positive")
+    }
+    return s.schedule(s.clock.Now().Add(interval), interval, task)
+}
+
+// RunDue runs every task whose deadline is reached, in deadline order, and returns how many ran.
+// Periodic tasks are rescheduled before running, so they can cancel themselves. A task cancelled
+// by an earlier task of the same batch is skipped, and not counted.
+func (s *Scheduler) RunDue() int {
+    s.mu.Lock()
+    now := s.clock.Now()
+    due := s.timers.due(now)
+    for _, t := range due {
+        if t.interval == 0 {
+            continue
+        }
+        // Next deadline after now, skipping the missed ones
+        missed := now.Sub(t.deadline) / t.interval
+        t.deadline = t.deadline.Add((missed + 1) * t.interval)
+        s.timers.add(t)
+    }
+    s.mu.Unlock()
+
+    // Outside the lock: tasks can schedule and cancel
+    ran := 0
+    for _, t := range due {
+        if !s.start(t) {
+            continue
+        }
+        t.task()
+        ran++
+    }
+    return ran
+}
+
+// start checks, right before running a due timer, that it was not cancelled since it was taken
+// out of the backend, and marks a one-shot timer as done
+func (s *Scheduler) start(t *timer) bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if t.done {
+        return false
+    }
+    if t.interval == 0 {
+        t.done = true
+    }
+    return true
+}
+
+// Run calls RunDue whenever a timer is due, until ctx is done, and returns ctx.Err().
+// Only one goroutine should call Run.
+func (s *Scheduler) Run(ctx context.Context) error {
+    for {
+        s.RunDue()
+
+        var fire <-chan time.Time
+        if next, ok := s.NextDeadline(); ok {
+            fire = s.clock.After(next.Sub(s.clock.Now()))
+        }
+        select {
+        case <-ctx.Done():
+            return ctx.Err()
+        case <-fire:
+        case <-s.wake:
+        }
+    }
+}
+
+// NextDeadline returns when the next task is due (for the timing wheel, the next tick),
+// false if nothing is scheduled.
+func (s *Scheduler) NextDeadline() (time.Time, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.timers.next()
+}
+
+// Pending returns the number of scheduled tasks.
+func (s *Scheduler) Pending() int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.timers.size()
+}
+
+// Handle refers to a scheduled task, to cancel it.
+type Handle struct {
+    scheduler *Scheduler
+    timer     *timer
+}
+
+// Cancel prevents the future runs of the task. Returns false if there are none: the one-shot
+// task already ran, or the task was already cancelled. A run already in progress is not stopped.
+func (h *Handle) Cancel() bool {
+    s := h.scheduler
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if h.timer.done {
+        return false
+    }
+    h.timer.done = true
+    // A due timer waiting for its turn in RunDue is already out of the backend: RunDue skips it
+    s.timers.remove(h.timer)
+    return true
+}
+
+// Deadline returns when the task runs next, false if it will not run again.
+func (h *Handle) Deadline() (time.Time, bool) {
+    s := h.scheduler
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if h.timer.done {
+        return time.Time{}, false
+    }
+    return h.timer.deadline, true
+}
@@ -0,0 +1,316 @@
+package scheduler
+
+import (
+    "context"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// backends runs every test against both the heap and the timing wheel. The wheel uses a 1ms tick
+// and the tests only use whole milliseconds, so both fire at the exact deadline.
+var backends = []struct {
+    name string
+    new  func(clock Clock) *Scheduler
+}{
+    {"Heap", func(clock Clock) *Scheduler { return New(clock) }},
+    {"TimingWheel", func(clock Clock) *Scheduler { return NewTimingWheel(clock, time.Millisecond) }},
+}
+
+// recorder collects the names of the tasks that ran, in order
+type recorder struct {
+    mu  sync.Mutex
+    ran []string
+}
+
+func (r *recorder) task(name string) func() {
+    return func() {
+        r.mu.Lock()
+        defer r.mu.Unlock()
+        r.ran = append(r.ran, name)
+    }
+}
+
+func (r *recorder) runs() []string {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    return append([]string(nil), r.ran...)
+}
+
+func TestScheduler_Deadlines(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            s.After(3*time.Second, r.task("c"))
+            s.After(time.Second, r.task("a"))
+            s.At(epoch.Add(2*time.Second), r.task("b"))
+            assert.Equal(t, 3, s.Pending())
+
+            assert.Equal(t, 0, s.RunDue())
+            clock.Advance(999 * time.Millisecond)
+            assert.Equal(t, 0, s.RunDue(), "no task runs early")
+
+            clock.Advance(time.Millisecond)
+            assert.Equal(t, 1, s.RunDue())
+            assert.Equal(t, []string{"a"}, r.runs())
+
+            clock.Advance(time.Hour)
+            assert.Equal(t, 2, s.RunDue())
+            assert.Equal(t, []string{"a", "b", "c"}, r.runs())
+            assert.Equal(t, 0, s.Pending())
+        })
+    }
+}
+
+func TestScheduler_SameDeadlineInSchedulingOrder(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            s.After(time.Second, r.task("first"))
+            s.After(time.Second, r.task("second"))
+            s.After(500*time.Millisecond, r.task("earlier"))
+            s.After(time.Second, r.task("third"))
+
+            clock.Advance(time.Second)
+            s.RunDue()
+            assert.Equal(t, []string{"earlier", "first", "second", "third"}, r.runs())
+        })
+    }
+}
+
+func TestScheduler_PastDeadline(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            s.At(epoch.Add(-time.Hour), r.task("late"))
+            s.After(0, r.task("now"))
+            assert.Equal(t, 2, s.RunDue())
+            assert.Equal(t, []string{"late", "now"}, r.runs())
+        })
+    }
+}
+
+func TestScheduler_Cancel(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            kept := s.After(time.Second, r.task("kept"))
+            cancelled := s.After(time.Second, r.task("cancelled"))
+
+            deadline, ok := cancelled.Deadline()
+            assert.True(t, ok)
+            assert.Equal(t, epoch.Add(time.Second), deadline)
+
+            assert.True(t, cancelled.Cancel())
+            assert.False(t, cancelled.Cancel(), "already cancelled")
+            _, ok = cancelled.Deadline()
+            assert.False(t, ok)
+            assert.Equal(t, 1, s.Pending())
+
+            clock.Advance(time.Second)
+            s.RunDue()
+            assert.Equal(t, []string{"kept"}, r.runs())
+            assert.False(t, kept.Cancel(), "already ran")
+            _, ok = kept.Deadline()
+            assert.False(t, ok)
+        })
+    }
+}
+
+func TestScheduler_Every(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            runs := 0
+
+            handle := s.Every(10*time.Second, func() { runs++ })
+            for i := 1; i <= 3; i++ {
+                clock.Advance(10 * time.Second)
+                s.RunDue()
+                assert.Equal(t, i, runs)
+            }
+
+            // 35s late: the 3 missed runs are skipped, and the schedule stays aligned
+            clock.Advance(45 * time.Second)
+            assert.Equal(t, 1, s.RunDue())
+            assert.Equal(t, 4, runs)
+            deadline, ok := handle.Deadline()
+            assert.True(t, ok)
+            assert.Equal(t, epoch.Add(80*time.Second), deadline)
+
+            assert.True(t, handle.Cancel())
+            clock.Advance(time.Hour)
+            assert.Equal(t, 0, s.RunDue())
+            assert.Equal(t, 4, runs)
+        })
+    }
+}
+
+func TestScheduler_EveryCancelsItself(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            runs := 0
+
+            var handle *Handle
+            handle = s.Every(time.Second, func() {
+                runs++
+                if runs == 2 {
+                    assert.True(t, handle.Cancel())
+                }
+            })
+            for range 5 {
+                clock.Advance(time.Second)
+                s.RunDue()
+            }
+            assert.Equal(t, 2, runs)
+            assert.Equal(t, 0, s.Pending())
+        })
+    }
+}
+
+func TestScheduler_CancelInSameBatch(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            // Both due on the same RunDue: the first one cancels the others before they run
+            var oneShot, periodic *Handle
+            s.After(time.Second, func() {
+                r.task("canceller")()
+                assert.True(t, oneShot.Cancel())
+                assert.True(t, periodic.Cancel())
+            })
+            oneShot = s.After(time.Second, r.task("one-shot"))
+            periodic = s.Every(time.Second, r.task("periodic"))
+
+            clock.Advance(time.Second)
+            assert.Equal(t, 1, s.RunDue(), "cancelled tasks are not counted")
+            assert.Equal(t, []string{"canceller"}, r.runs())
+            assert.False(t, oneShot.Cancel())
+            assert.False(t, periodic.Cancel())
+            assert.Equal(t, 0, s.Pending())
+
+            clock.Advance(time.Hour)
+            assert.Equal(t, 0, s.RunDue())
+            assert.Equal(t, []string{"canceller"}, r.runs())
+        })
+    }
+}
+
+func TestScheduler_TaskSchedulesTask(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            r := &recorder{}
+
+            // A retry with backoff, scheduled from the failing attempt
+            s.After(time.Second, func() {
+                r.task("attempt")()
+                s.After(2*time.Second, r.task("retry"))
+            })
+
+            clock.Advance(time.Second)
+            assert.Equal(t, 1, s.RunDue())
+            assert.Equal(t, 1, s.Pending())
+
+            clock.Advance(2 * time.Second)
+            assert.Equal(t, 1, s.RunDue())
+            assert.Equal(t, []string{"attempt", "retry"}, r.runs())
+        })
+    }
+}
+
+func TestScheduler_InvalidArguments(t *testing.T) {
+    assert.Panics(t, func() { New(nil).Every(0, func() {}) })
+    assert.Panics(t, func() { NewTimingWheel(nil, 0) })
+}
+
+func TestScheduler_NextDeadline(t *testing.T) {
+    s := New(NewFakeClock(epoch))
+    _, ok := s.NextDeadline()
+    assert.False(t, ok)
+
+    s.After(time.Minute, func() {})
+    s.After(time.Second, func() {})
+    next, ok := s.NextDeadline()
+    assert.True(t, ok)
+    assert.Equal(t, epoch.Add(time.Second), next)
+}
+
+func TestScheduler_Run(t *testing.T) {
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            ctx, cancel := context.WithCancel(context.Background())
+            done := make(chan error)
+            go func() { done <- s.Run(ctx) }()
+
+            var runs atomic.Int32
+            s.After(5*time.Second, func() { runs.Add(1) })
+
+            // Wait until Run sleeps on the clock for the new timer, then wake it up
+            assert.Eventually(t, func() bool { return clock.Waiters() > 0 }, time.Second, time.Millisecond)
+            clock.Advance(5 * time.Second)
+            assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, time.Millisecond)
+
+            cancel()
+            assert.ErrorIs(t, <-done, context.Canceled)
+        })
+    }
+}
+
+func TestScheduler_Concurrent(t *testing.T) {
+    const goroutines, perRoutine = 8, 200
+
+    for _, backend := range backends {
+        t.Run(backend.name, func(t *testing.T) {
+            clock := NewFakeClock(epoch)
+            s := backend.new(clock)
+            var runs atomic.Int32
+
+            var wg sync.WaitGroup
+            for g := range goroutines {
+                wg.Add(1)
+                go func() {
+                    defer wg.Done()
+                    for i := range perRoutine {
+                        handle := s.After(time.Duration(i+1)*time.Millisecond, func() { runs.Add(1) })
+                        // Every other goroutine cancels half of its tasks
+                        if g%2 == 1 && i%2 == 0 {
+                            assert.True(t, handle.Cancel())
+                        }
+                        s.RunDue()
+                    }
+                }()
+            }
+            wg.Wait()
+
+            clock.Advance(time.Second)
+            s.RunDue()
+            assert.Equal(t, int32(goroutines*perRoutine-goroutines/2*perRoutine/2), runs.Load())
+            assert.Equal(t, 0, s.Pending())
+        })
+    }
+}
@@ -0,0 +1,180 @@
+package scheduler
+
+import (
+    "math"
+    "slices"
+    "time"
+)
+
+const (
+    wheelBits   = 6
+    wheelSize   = 1 << wheelBits // Slots per level
+    wheelMask   = wheelSize - 1
+    wheelLevels = 6 // 64^6 ticks: over two years with a 1ms tick
+)
+
+// timingWheel is a hierarchical timing wheel: a set of circular arrays of slots, where a slot of
+// level 0 spans one tick, a slot of level 1 spans 64 ticks, a slot of level 2 spans 64² ticks...
+//
+//   level 2  [    |    |    | ...  ]   64² ticks per slot
+//   level 1  [    |    |    | ...  ]   64 ticks per slot
+//   level 0  [ | | | | | | | ...    ]   1 tick per slot  <- fired when current reaches the slot
+//
+// A timer goes to the lowest level whose range covers its distance to the current tick. When the
+// current tick crosses a boundary of level l, the matching slot of level l is "cascaded": its
+// timers are placed again, now closer, in a lower level. A timer is moved at most once per level,
+// so schedule, cancel and fire are all O(1) amortized, whatever the number of timers.
+//
+// Timers further than the range of the top level wait in the top level and are placed again
+// when cascaded, until they are in range.
+type timingWheel struct {
+    start   time.Time
+    tick    time.Duration
+    current int64 // Next tick to process
+    slots   [wheelLevels][wheelSize]map[uint64]*timer
+    counts  [wheelLevels]int // Timers per level
+    total   int
+}
+
+func newTimingWheel(start time.Time, tick time.Duration) *timingWheel {
+    w := &timingWheel{start: start, tick: tick}
+    for level := range w.slots {
+        for slot := range w.slots[level] {
+            w.slots[level][slot] = make(map[uint64]*timer)
+        }
+    }
+    return w
+}
+
+// tickOf returns the first tick at or after deadline, so timers never fire early
+func (w *timingWheel) tickOf(deadline time.Time) int64 {
+    d := deadline.Sub(w.start)
+    if d <= 0 {
+        return 0
+    }
+    return int64((d + w.tick - 1) / w.tick)
+}
+
+// span returns the number of ticks covered by the levels up to the given one (excluded)
+func span(level int) int64 {
+    return 1 << (wheelBits * level)
+}
+
+// place stores a timer in the level covering its distance from the current tick
+func (w *timingWheel) place(t *timer) {
+    tick := max(t.tick, w.current)
+
+    level := 0
+    for level < wheelLevels-1 && tick-w.current >= span(level+1) {
+        level++
+    }
+    if tick-w.current >= span(wheelLevels) {
+        // Out of range: wait in the furthest slot of the top level, and be placed again later
+        tick = w.current + span(wheelLevels) - 1
+    }
+
+    slot := int((tick >> (wheelBits * level)) & wheelMask)
+    w.slots[level][slot][t.id] = t
+    t.level, t.slot = level, slot
+    w.counts[level]++
+    w.total++
+}
+
+// takeSlot empties a slot, returning its timers
+func (w *timingWheel) takeSlot(level, slot int) []*timer {
+    timers := make([]*timer, 0, len(w.slots[level][slot]))
+    for _, t := range w.slots[level][slot] {
+        timers = append(timers, t)
+    }
+    clear(w.slots[level][slot])
+    w.counts[level] -= len(timers)
+    w.total -= len(timers)
+    return timers
+}
+
+func (w *timingWheel) add(t *timer) {
+    t.tick = w.tickOf(t.deadline)
+    w.place(t)
+}
+
+func (w *timingWheel) remove(t *timer) bool {
+    if _, ok := w.slots[t.level][t.slot][t.id]; !ok {
+        return false
+    }
+    delete(w.slots[t.level][t.slot], t.id)
+    w.counts[t.level]--
+    w.total--
+    return true
+}
+
+// due processes every tick up to now, cascading the higher levels and firing level 0
+func (w *timingWheel) due(now time.Time) []*timer {
+    if now.Before(w.start) {
+        return nil
+    }
+    target := int64(now.Sub(w.start) / w.tick)
+
+    var due []*timer
+    for w.current <= target {
+        if w.total == 0 {
+            w.current = target + 1
+            break
+        }
+
+        // Cascade from the top: a timer moved down may land in a slot cascaded on the same tick
+        for level := wheelLevels - 1; level > 0; level-- {
+            if w.current%span(level) == 0 {
+                slot := int((w.current >> (wheelBits * level)) & wheelMask)
+                for _, t := range w.takeSlot(level, slot) {
+                    w.place(t)
+                }
+            }
+        }
+        due = append(due, w.takeSlot(0, int(w.current&wheelMask))...)
+        w.current++
+
+        // Skip the ticks that cannot fire or cascade anything
+        if w.total > 0 {
+            w.current = min(w.nextEvent(), target+1)
+        }
+    }
+
+    slices.SortFunc(due, compareTimers)
+    return due
+}
+
+// nextEvent returns the first tick, from the current one, that can fire or cascade timers: the
+// first non empty slot of level 0, or the next boundary of the lowest non empty level above.
+// Requires a non empty wheel.
+func (w *timingWheel) nextEvent() int64 {
+    event := int64(math.MaxInt64)
+    if w.counts[0] > 0 {
+        // Level 0 only holds the next 64 ticks, one per slot
+        for tick := w.current; ; tick++ {
+            if len(w.slots[0][tick&wheelMask]) > 0 {
+                event = tick
+                break
+            }
+        }
+    }
+    for level := 1; level < wheelLevels; level++ {
+        if w.counts[level] > 0 {
+            step := span(level)
+            return min(event, (w.current+step-1)/step*step)
+        }
+    }
+    return event
+}
+
+// next returns the time of the next tick that can fire or cascade timers, so that Run does not
+// wake up on every tick while only far away timers are pending
+func (w *timingWheel) next() (time.Time, bool) {
+    if w.total == 0 {
+        return time.Time{}, false
+    }
+    return w.start.Add(time.Duration(w.nextEvent()) * w.tick), true
+}
+
+func (w *timingWheel) size() int {
+    return w.total
+}
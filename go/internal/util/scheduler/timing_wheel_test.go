@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+    "math/rand"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func newTestTimer(id uint64, deadline time.Time) *timer {
+    return &timer{id: id, deadline: deadline}
+}
+
+func TestTimingWheel_Levels(t *testing.T) {
+    w := newTimingWheel(epoch, time.Millisecond)
+
+    near := newTestTimer(1, epoch.Add(10*time.Millisecond))
+    middle := newTestTimer(2, epoch.Add(100*time.Millisecond))
+    far := newTestTimer(3, epoch.Add(time.Hour))
+    w.add(near)
+    w.add(middle)
+    w.add(far)
+    assert.Equal(t, 0, near.level)
+    assert.Equal(t, 1, middle.level)
+    assert.Equal(t, 3, far.level) // 3.6M ticks: between 64^3 and 64^4
+    assert.Equal(t, 3, w.size())
+
+    assert.Empty(t, w.due(epoch.Add(9*time.Millisecond)))
+    assert.Equal(t, []*timer{near}, w.due(epoch.Add(10*time.Millisecond)))
+    assert.Equal(t, []*timer{middle}, w.due(epoch.Add(time.Minute)))
+    assert.Equal(t, []*timer{far}, w.due(epoch.Add(time.Hour)))
+    assert.Equal(t, 0, w.size())
+}
+
+func TestTimingWheel_RoundsUpToTick(t *testing.T) {
+    w := newTimingWheel(epoch, 10*time.Millisecond)
+    late := newTestTimer(1, epoch.Add(15*time.Millisecond))
+    w.add(late)
+
+    // Due at 15ms, but only fired on the 20ms tick: late, never early
+    assert.Empty(t, w.due(epoch.Add(19*time.Millisecond)))
+    next, ok := w.next()
+    assert.True(t, ok)
+    assert.Equal(t, epoch.Add(20*time.Millisecond), next)
+    assert.Equal(t, []*timer{late}, w.due(epoch.Add(20*time.Millisecond)))
+}
+
+func TestTimingWheel_NextSkipsEmptyLevels(t *testing.T) {
+    w := newTimingWheel(epoch, time.Millisecond)
+    _, ok := w.next()
+    assert.False(t, ok)
+
+    // 100ms is on level 1: nothing happens before the cascade on tick 64
+    w.add(newTestTimer(1, epoch.Add(100*time.Millisecond)))
+    assert.Empty(t, w.due(epoch))
+    next, ok := w.next()
+    assert.True(t, ok)
+    assert.Equal(t, epoch.Add(64*time.Millisecond), next)
+
+    // After the cascade the timer is on level 0, at its exact tick
+    assert.Empty(t, w.due(next))
+    next, _ = w.next()
+    assert.Equal(t, epoch.Add(100*time.Millisecond), next)
+}
+
+func TestTimingWheel_BeyondRange(t *testing.T) {
+    // With a 1ns tick the six levels cover 64^6ns, about 69s
+    w := newTimingWheel(epoch, time.Nanosecond)
+    late := newTestTimer(1, epoch.Add(200*time.Second))
+    w.add(late)
+    assert.Equal(t, wheelLevels-1, late.level)
+
+    assert.Empty(t, w.due(epoch.Add(100*time.Second)))
+    assert.Empty(t, w.due(epoch.Add(200*time.Second-time.Nanosecond)))
+    assert.Equal(t, []*timer{late}, w.due(epoch.Add(200*time.Second)))
+}
+
+func TestTimingWheel_Remove(t *testing.T) {
+    w := newTimingWheel(epoch, time.Millisecond)
+    a := newTestTimer(1, epoch.Add(time.Second))
+    b := newTestTimer(2, epoch.Add(time.Second))
+    w.add(a)
+    w.add(b)
+
+    assert.True(t, w.remove(a))
+    assert.False(t, w.remove(a))
+    assert.Equal(t, 1, w.size())
+    assert.Equal(t, []*timer{b}, w.due(epoch.Add(time.Second)))
+    assert.False(t, w.remove(b))
+}
+
+// TestTimingWheel_MatchesHeap checks the wheel against the heap on random deadlines spread over
+// every level, advancing the clock by random steps
+func TestTimingWheel_MatchesHeap(t *testing.T) {
+    rng := rand.New(rand.NewSource(42))
+    wheel := newTimingWheel(epoch, time.Millisecond)
+    reference := newHeapBackend()
+
+    for id := uint64(1); id <= 5000; id++ {
+        // Exponentially spread deadlines: from 1ms to about 4.6 hours
+        delay := time.Duration(1<<rng.Intn(24)+rng.Intn(1000)) * time.Millisecond
+        deadline := epoch.Add(delay)
+        wheel.add(newTestTimer(id, deadline))
+        reference.add(newTestTimer(id, deadline))
+    }
+
+    now := epoch
+    for reference.size() > 0 {
+        now = now.Add(time.Duration(rng.Int63n(int64(10 * time.Minute))))
+        expected := reference.due(now)
+        actual := wheel.due(now)
+        if !assert.Equal(t, len(expected), len(actual), "at %v", now.Sub(epoch)) {
+            return
+        }
+        for i := range expected {
+            assert.Equal(t, expected[i].id, actual[i].id)
+        }
+    }
+    assert.Equal(t, 0, wheel.size())
+}
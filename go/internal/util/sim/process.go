@@ -0,0 +1,102 @@
+package sim
+
+import (
+    "iter"
+)
+
+// Process is a long-lived actor of a simulation, written as sequential code that waits by
+// yielding a delay:
+//
+//   s.Spawn(func(yield func(float64) bool) {
+//       for {
+//           if !yield(s.Exponential(mttf)) { // Wait until the next failure
+//               return                       // Interrupted
+//           }
+//           down++
+//           if !yield(s.Uniform(1, 3)) { // Wait for the repair
+//               return
+//           }
+//       }
+//   })
+//
+// Each resumption is an event of the simulation, so processes and plain handlers interleave in
+// virtual time order. The body runs as a coroutine (iter.Pull): only one of them runs at a time,
+// and control always comes back to the simulation, so runs stay deterministic.
+type Process struct {
+    sim         *Simulation
+    next        func() (float64, bool)
+    stop        func()
+    wake        *Event // Next resumption
+    running     bool   // The body is running: it yielded nothing yet
+    interrupted bool
+    done        bool
+}
+
+// Spawn starts a process whose body is a sequence of delays to wait. The body starts after the
+// events already scheduled for the current time, and the process ends when the body returns.
+// Any delay that is negative or NaN panics, as with Schedule.
+func (s *Simulation) Spawn(body iter.Seq[float64]) *Process {
+    next, stop := iter.Pull(body)
+    p := &Process{sim: s, next: next, stop: stop}
+    p.wake = s.Schedule(0, p.resume)
+
+    // Drop the finished processes, so that long simulations spawning many don't grow the slice
+    alive := s.processes[:0]
+    for _, process := range s.processes {
+        if !process.done {
+            alive = append(alive, process)
+        }
+    }
+    clear(s.processes[len(alive):])
+    s.processes = append(alive, p)
+    return p
+}
+
+// resume runs the body until its next yield, and schedules the following resumption
+func (p *Process) resume() {
+    p.running = true
+    delay, ok := p.next()
+    p.running = false
+    if !ok || p.interrupted {
+        p.finish()
+        return
+    }
+    p.wake = p.sim.Schedule(delay, p.resume)
+}
+
+// finish releases the coroutine: a suspended body sees yield return false
+func (p *Process) finish() {
+    p.done = true
+    p.stop()
+}
+
+// Interrupt ends the process: its next resumption is cancelled, and the pending yield returns
+// false so that the body can clean up and return. Called from the body itself, it takes effect
+// at its next yield. Returns false if the process already ended or was interrupted.
+func (p *Process) Interrupt() bool {
+    if p.done || p.interrupted {
+        return false
+    }
+    p.interrupted = true
+    if p.running {
+        // Finished by resume, once the body yields
+        return true
+    }
+    p.wake.Cancel()
+    p.finish()
+    return true
+}
+
+// Done returns true once the body returned or the process was interrupted.
+func (p *Process) Done() bool {
+    return p.done
+}
+
+// Close interrupts every process still alive, in spawn order, so that their coroutines are
+// released. Call it when a simulation is abandoned with processes still waiting.
+func (s *Simulation) Close() {
+    for _, process := range s.processes {
+        process.Interrupt()
+    }
+    s.processes = nil
+}
@@ -0,0 +1,132 @@
+package sim
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestProcess_InterleavesWithEvents(t *testing.T) {
+    s := New(1)
+    var trace []string
+
+    s.Schedule(1.5, func() { trace = append(trace, "event@1.5") })
+    p := s.Spawn(func(yield func(float64) bool) {
+        trace = append(trace, "start@0")
+        for range 2 {
+            if !yield(1) {
+                return
+            }
+            trace = append(trace, "tick")
+        }
+    })
+
+    s.Run()
+    assert.Equal(t, []string{"start@0", "tick", "event@1.5", "tick"}, trace)
+    assert.Equal(t, 2.0, s.Now())
+    assert.True(t, p.Done())
+}
+
+func TestProcess_SameTimeOrder(t *testing.T) {
+    s := New(1)
+    var trace []string
+
+    // Both processes wake up at the same times: they alternate in spawn order
+    for _, name := range []string{"a", "b"} {
+        s.Spawn(func(yield func(float64) bool) {
+            for range 3 {
+                trace = append(trace, name)
+                if !yield(1) {
+                    return
+                }
+            }
+        })
+    }
+
+    s.Run()
+    assert.Equal(t, []string{"a", "b", "a", "b", "a", "b"}, trace)
+}
+
+func TestProcess_Interrupt(t *testing.T) {
+    s := New(1)
+    ticks, cleanedUp := 0, false
+
+    p := s.Spawn(func(yield func(float64) bool) {
+        defer func() { cleanedUp = true }()
+        for yield(1) {
+            ticks++
+        }
+    })
+    s.At(3.5, func() { assert.True(t, p.Interrupt()) })
+
+    s.Run()
+    assert.Equal(t, 3, ticks)
+    assert.True(t, cleanedUp)
+    assert.True(t, p.Done())
+    assert.False(t, p.Interrupt())
+    assert.Equal(t, 3.5, s.Now(), "no resumption left after the interrupt")
+}
+
+func TestProcess_InterruptItself(t *testing.T) {
+    s := New(1)
+    ticks := 0
+
+    var p *Process
+    p = s.Spawn(func(yield func(float64) bool) {
+        for {
+            ticks++
+            if ticks == 2 {
+                assert.True(t, p.Interrupt())
+            }
+            if !yield(1) {
+                return
+            }
+        }
+    })
+
+    s.Run()
+    assert.Equal(t, 2, ticks)
+    assert.True(t, p.Done())
+    assert.Equal(t, 0, s.Pending())
+}
+
+func TestProcess_SpawnsEvents(t *testing.T) {
+    s := New(1)
+    arrivals := 0
+
+    // A generator process producing arrivals, each handled by a plain event
+    s.Spawn(func(yield func(float64) bool) {
+        for range 100 {
+            s.Schedule(0, func() { arrivals++ })
+            if !yield(s.Exponential(2)) {
+                return
+            }
+        }
+    })
+
+    s.Run()
+    assert.Equal(t, 100, arrivals)
+    assert.InDelta(t, 200, s.Now(), 60)
+}
+
+func TestSimulation_Close(t *testing.T) {
+    s := New(1)
+    released := 0
+    var processes []*Process
+    for range 3 {
+        processes = append(processes, s.Spawn(func(yield func(float64) bool) {
+            defer func() { released++ }()
+            for yield(10) {
+            }
+        }))
+    }
+    s.RunUntil(25)
+    assert.Equal(t, 0, released)
+
+    s.Close()
+    assert.Equal(t, 3, released)
+    for _, p := range processes {
+        assert.True(t, p.Done())
+    }
+    assert.Equal(t, 0, s.Pending())
+}
@@ -0,0 +1,212 @@
+// Package sim is a discrete-event simulation engine.
+//
+// A Simulation owns a virtual clock and a queue of events, each a func() to run at a point of
+// virtual time. Running the simulation pops the earliest event, moves the clock to its time and
+// runs it; handlers schedule the future events. Nothing sleeps: an hour of simulated traffic runs
+// as fast as its handlers.
+//
+//   s := sim.New(42)
+//   var arrive func()
+//   arrive = func() {
+//       served++
+//       s.Schedule(s.Exponential(meanInterArrival), arrive)
+//   }
+//   s.Schedule(0, arrive)
+//   s.RunUntil(3600)
+//
+// Long-lived actors can also be written as processes (see Spawn), which wait by yielding delays.
+//
+// Runs are reproducible: events at the same time run in the order they were scheduled, and every
+// random draw comes from the simulation's RNG, seeded by New.
+package sim
+
+import (
+    "fmt"
+    "math"
+    "math/rand/v2"
+
+    "interview_go/internal/util/heap"
+)
+
+// Event is a handler scheduled at a point of virtual time.
+type Event struct {
+    time      float64
+    seq       uint64 // Scheduling order, breaks ties between events at the same time
+    handler   func()
+    sim       *Simulation
+    cancelled bool
+    done      bool
+}
+
+// compareEvents orders events by time, then by scheduling order
+func compareEvents(a, b *Event) int {
+    switch {
+    case a.time < b.time:
+        return -1
+    case a.time > b.time:
+        return 1
+    case a.seq < b.seq:
+        return -1
+    case a.seq > b.seq:
+        return 1
+    }
+    return 0
+}
+
+// Time returns the virtual time the event is scheduled at.
+func (e *Event) Time() float64 {
+    return e.time
+}
+
+// Cancel prevents the event from running. Returns false if it already ran or was cancelled.
+//
+// The event stays in the queue until its time, and is skipped then: the heap has no removal.
+func (e *Event) Cancel() bool {
+    if e.done || e.cancelled {
+        return false
+    }
+    e.cancelled = true
+    e.sim.pending--
+    return true
+}
+
+// Simulation is a virtual clock and its queue of events. It is not safe for concurrent use:
+// handlers run one at a time, on the goroutine calling Step or Run.
+type Simulation struct {
+    now       float64
+    events    *heap.ImplHeap[*Event]
+    seq       uint64
+    pending   int // Events not cancelled yet
+    processed int
+    stopped   bool
+    rng       *rand.Rand
+    processes []*Process // Spawned processes, in spawn order; finished ones are removed lazily
+}
+
+// New creates a Simulation at time 0, whose RNG is seeded with seed.
+func New(seed uint64) *Simulation {
+    return &Simulation{
+        events: heap.NewMinHeap(compareEvents),
+        rng:    rand.New(rand.NewPCG(seed, seed)),
+    }
+}
+
+// Now returns the current virtual time.
+func (s *Simulation) Now() float64 {
+    return s.now
+}
+
+// At schedules handler at the given virtual time. Panics if time is in the past or NaN.
+//
+// Time complexity: O(log n)
+func (s *Simulation) At(time float64, handler func()) *Event {
+    if !(time >= s.now) {
+        panic(fmt.Sprintf("sim: cannot schedule at %v, before the current time %v", time, s.now))
+    }
+    s.seq++
+    event := &Event{time: time, seq: s.seq, handler: handler, sim: s}
+    s.events.Push(event)
+    s.pending++
+    return event
+}
+
+// Schedule schedules handler delay after the current time. A zero delay runs it after the events
+// already scheduled for now. Panics if delay is negative or NaN.
+//
+// Time complexity: O(log n)
+func (s *Simulation) Schedule(delay float64, handler func()) *Event {
+    if !(delay >= 0) {
+        panic(fmt.Sprintf("sim: invalid delay %v", delay))
+    }
+    return s.At(s.now+delay, handler)
+}
+
+// next pops the next event not cancelled, without running it
+func (s *Simulation) next() (*Event, bool) {
+    for {
+        event, ok := s.events.Pop()
+        if !ok || !event.cancelled {
+            return event, ok
+        }
+    }
+}
+
+// peek returns the next event not cancelled, dropping the cancelled ones on top of the queue
+func (s *Simulation) peek() (*Event, bool) {
+    for {
+        event, ok := s.events.Peek()
+        if !ok || !event.cancelled {
+            return event, ok
+        }
+        s.events.Pop()
+    }
+}
+
+// Step runs the next event, moving the clock to its time. Returns false if there is none.
+func (s *Simulation) Step() bool {
+    event, ok := s.next()
+    if !ok {
+        return false
+    }
+    s.now = event.time
+    event.done = true
+    s.pending--
+    s.processed++
+    event.handler()
+    return true
+}
+
+// Run runs events until there are none left, or a handler calls Stop.
+func (s *Simulation) Run() {
+    s.stopped = false
+    for !s.stopped && s.Step() {
+    }
+}
+
+// RunUntil runs the events scheduled up to end (included), then moves the clock to end, unless
+// a handler called Stop. Events after end stay scheduled for a later call.
+func (s *Simulation) RunUntil(end float64) {
+    s.stopped = false
+    for !s.stopped {
+        event, ok := s.peek()
+        if !ok || event.time > end {
+            break
+        }
+        s.Step()
+    }
+    if !s.stopped {
+        s.now = math.Max(s.now, end)
+    }
+}
+
+// Stop makes Run or RunUntil return after the current handler.
+func (s *Simulation) Stop() {
+    s.stopped = true
+}
+
+// Pending returns the number of events scheduled and not cancelled.
+func (s *Simulation) Pending() int {
+    return s.pending
+}
+
+// Processed returns the number of events run so far.
+func (s *Simulation) Processed() int {
+    return s.processed
+}
+
+// Rand returns the random number generator of the simulation. Drawing from it, instead of a
+// global source, keeps runs with the same seed identical.
+func (s *Simulation) Rand() *rand.Rand {
+    return s.rng
+}
+
+// Exponential returns an exponentially distributed delay with the given mean, as the time between
+// arrivals of a Poisson process.
+func (s *Simulation) Exponential(mean float64) float64 {
+    return s.rng.ExpFloat64() * mean
+}
+
+// Uniform returns a delay uniformly distributed in [low, high).
+func (s *Simulation) Uniform(low, high float64) float64 {
+    return low + s.rng.Float64()*(high-low)
+}
@@ -0,0 +1,191 @@
+package sim
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestSimulation_TimeOrder(t *testing.T) {
+    s := New(1)
+    var trace []float64
+    record := func() { trace = append(trace, s.Now()) }
+
+    s.Schedule(3, record)
+    s.Schedule(1, record)
+    s.At(2, record)
+    assert.Equal(t, 3, s.Pending())
+
+    s.Run()
+    assert.Equal(t, []float64{1, 2, 3}, trace)
+    assert.Equal(t, 3.0, s.Now())
+    assert.Equal(t, 3, s.Processed())
+    assert.Equal(t, 0, s.Pending())
+}
+
+func TestSimulation_TiesInSchedulingOrder(t *testing.T) {
+    s := New(1)
+    var trace []string
+    for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+        s.Schedule(5, func() { trace = append(trace, name) })
+    }
+    s.Run()
+    assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g", "h"}, trace)
+}
+
+func TestSimulation_HandlersSchedule(t *testing.T) {
+    s := New(1)
+    var trace []string
+
+    s.Schedule(1, func() {
+        trace = append(trace, "first")
+        // Zero delay: after the events already scheduled for now
+        s.Schedule(0, func() { trace = append(trace, "follow-up") })
+        s.Schedule(1, func() { trace = append(trace, "later") })
+    })
+    s.Schedule(1, func() { trace = append(trace, "second") })
+
+    s.Run()
+    assert.Equal(t, []string{"first", "second", "follow-up", "later"}, trace)
+    assert.Equal(t, 2.0, s.Now())
+}
+
+func TestSimulation_Cancel(t *testing.T) {
+    s := New(1)
+    ran := false
+    event := s.Schedule(1, func() { ran = true })
+    assert.Equal(t, 1.0, event.Time())
+
+    assert.True(t, event.Cancel())
+    assert.False(t, event.Cancel())
+    assert.Equal(t, 0, s.Pending())
+
+    s.Run()
+    assert.False(t, ran)
+    assert.Equal(t, 0, s.Processed())
+
+    done := s.Schedule(1, func() {})
+    s.Run()
+    assert.False(t, done.Cancel(), "already ran")
+}
+
+func TestSimulation_RunUntil(t *testing.T) {
+    s := New(1)
+    var trace []float64
+    for _, time := range []float64{1, 5, 10, 15} {
+        s.At(time, func() { trace = append(trace, s.Now()) })
+    }
+
+    s.RunUntil(10)
+    assert.Equal(t, []float64{1, 5, 10}, trace)
+    assert.Equal(t, 10.0, s.Now())
+
+    // The clock moves to end even without events
+    s.RunUntil(12)
+    assert.Equal(t, 12.0, s.Now())
+    assert.Equal(t, 1, s.Pending())
+
+    s.RunUntil(100)
+    assert.Equal(t, []float64{1, 5, 10, 15}, trace)
+    assert.Equal(t, 100.0, s.Now())
+}
+
+func TestSimulation_Stop(t *testing.T) {
+    s := New(1)
+    count := 0
+    var tick func()
+    tick = func() {
+        count++
+        if count == 3 {
+            s.Stop()
+        }
+        s.Schedule(1, tick)
+    }
+    s.Schedule(1, tick)
+
+    s.RunUntil(100)
+    assert.Equal(t, 3, count)
+    assert.Equal(t, 3.0, s.Now(), "the clock stays on the stopping event")
+
+    // Running again resumes
+    s.RunUntil(5)
+    assert.Equal(t, 5, count)
+}
+
+func TestSimulation_InvalidTimes(t *testing.T) {
+    s := New(1)
+    s.RunUntil(10)
+    assert.Panics(t, func() { s.At(5, func() {}) })
+    assert.Panics(t, func() { s.Schedule(-1, func() {}) })
+    assert.NotPanics(t, func() { s.At(10, func() {}) })
+}
+
+func TestSimulation_EmptyStep(t *testing.T) {
+    s := New(1)
+    assert.False(t, s.Step())
+    s.Run()
+    assert.Equal(t, 0.0, s.Now())
+}
+
+func TestSimulation_SeededRand(t *testing.T) {
+    draw := func(seed uint64) []float64 {
+        s := New(seed)
+        return []float64{s.Exponential(1), s.Uniform(2, 3), s.Rand().Float64()}
+    }
+    assert.Equal(t, draw(7), draw(7))
+    assert.NotEqual(t, draw(7), draw(8))
+
+    s := New(1)
+    for range 1000 {
+        value := s.Uniform(2, 3)
+        assert.True(t, value >= 2 && value < 3)
+        assert.True(t, s.Exponential(5) >= 0)
+    }
+}
+
+// mm1 simulates a single server queue with Poisson arrivals and exponential service times, and
+// returns the fraction of time the server was busy and the number of customers served
+func mm1(seed uint64, arrivalMean, serviceMean, duration float64) (float64, int) {
+    s := New(seed)
+    queued, served := 0, 0
+    busy, busySince := 0.0, 0.0
+
+    var arrive, depart func()
+    arrive = func() {
+        queued++
+        if queued == 1 {
+            busySince = s.Now()
+            s.Schedule(s.Exponential(serviceMean), depart)
+        }
+        s.Schedule(s.Exponential(arrivalMean), arrive)
+    }
+    depart = func() {
+        queued--
+        served++
+        if queued > 0 {
+            s.Schedule(s.Exponential(serviceMean), depart)
+        } else {
+            busy += s.Now() - busySince
+        }
+    }
+
+    s.Schedule(s.Exponential(arrivalMean), arrive)
+    s.RunUntil(duration)
+    if queued > 0 {
+        busy += duration - busySince
+    }
+    return busy / duration, served
+}
+
+func TestSimulation_MM1Queue(t *testing.T) {
+    utilization, served := mm1(42, 1, 0.8, 100000)
+
+    // Same seed, same run
+    again, servedAgain := mm1(42, 1, 0.8, 100000)
+    assert.Equal(t, utilization, again)
+    assert.Equal(t, served, servedAgain)
+
+    // Utilization converges to serviceMean / arrivalMean, and about one customer per time unit
+    assert.InDelta(t, 0.8, utilization, 0.02)
+    assert.InDelta(t, 100000, served, 2000)
+}
@@ -0,0 +1,139 @@
+package stack
+
+import "iter"
+
+// defaultCapacity is the initial capacity of an ArrayStack when no hint is given
+const defaultCapacity = 16
+
+// ShrinkPolicy decides, after a Pop, if the backing slice of an ArrayStack should be
+// reallocated to half of its capacity. It receives the current size and capacity.
+// The answer is ignored while the elements would not fit in half the capacity
+// (size > capacity/2), or when half would go below the initial capacity.
+type ShrinkPolicy func(size, capacity int) bool
+
+// NeverShrink keeps the backing slice at its largest capacity.
+// Best for stacks that are reused with similar heights, like traversal stacks.
+func NeverShrink(size, capacity int) bool {
+    return false
+}
+
+// ShrinkWhenQuarterFull halves the capacity once the stack is only a quarter full.
+// Waiting for a quarter (instead of a half) avoids reallocating back and forth when
+// pushing and popping around the boundary, so Push and Pop stay amortized O(1).
+func ShrinkWhenQuarterFull(size, capacity int) bool {
+    return size <= capacity/4
+}
+
+// ArrayStack implementation of a Stack using a growable slice.
+// The top of the stack is the end of the slice:
+//
+//   index:  0     1     2     3
+//         [ A ] [ B ] [ C ] [   ]
+//                        ↑
+//                       top
+//
+// Compared to DoubleLinkedListStack, Push does not allocate a node: the slice only
+// reallocates when it is full (doubling, amortized O(1)), and memory is contiguous.
+type ArrayStack[T any] struct {
+    data         []T
+    minCapacity  int // Never shrink below the initial capacity
+    shrinkPolicy ShrinkPolicy
+}
+
+// NewArrayStack creates an empty stack with room for capacity elements before growing.
+// A non-positive capacity uses the default. The stack never shrinks.
+func NewArrayStack[T any](capacity int) *ArrayStack[T] {
+    return NewArrayStackWithPolicy[T](capacity, NeverShrink)
+}
+
+// NewArrayStackWithPolicy creates an empty stack with a capacity hint and a shrink policy.
+func NewArrayStackWithPolicy[T any](capacity int, policy ShrinkPolicy) *ArrayStack[T] {
+    if capacity <= 0 {
+        capacity = defaultCapacity
+    }
+    if policy == nil {
+        policy = NeverShrink
+    }
+    return &ArrayStack[T]{
+        data:         make([]T, 0, capacity),
+        minCapacity:  capacity,
+        shrinkPolicy: policy,
+    }
+}
+
+var _ Stack[any] = (*ArrayStack[any])(nil)
+
+func (a *ArrayStack[T]) Push(t T) {
+    // Nil values are ignored, like in DoubleLinkedListStack
+    if any(t) == nil {
+        return
+    }
+    // append doubles the capacity when needed
+    a.data = append(a.data, t)
+}
+
+func (a *ArrayStack[T]) Pop() T {
+    val, err := a.TryPop()
+    if err != nil {
+        panic(err)
+    }
+    return val
+}
+
+func (a *ArrayStack[T]) TryPop() (T, error) {
+    if len(a.data) == 0 {
+        return *new(T), errEmpty
+    }
+    top := len(a.data) - 1
+    val := a.data[top]
+    // Release the slot, so the garbage collector can reclaim pointers
+    a.data[top] = *new(T)
+    a.data = a.data[:top]
+    a.shrink()
+    return val, nil
+}
+
+// shrink halves the backing slice when the policy asks for it
+func (a *ArrayStack[T]) shrink() {
+    capacity := cap(a.data)
+    if capacity/2 < a.minCapacity || len(a.data) > capacity/2 {
+        return
+    }
+    if !a.shrinkPolicy(len(a.data), capacity) {
+        return
+    }
+    data := make([]T, len(a.data), capacity/2)
+    copy(data, a.data)
+    a.data = data
+}
+
+func (a *ArrayStack[T]) Peek() (T, bool) {
+    if len(a.data) == 0 {
+        return *new(T), false
+    }
+    return a.data[len(a.data)-1], true
+}
+
+func (a *ArrayStack[T]) Size() int {
+    return len(a.data)
+}
+
+func (a *ArrayStack[T]) IsEmpty() bool {
+    return len(a.data) == 0
+}
+
+// Capacity returns the number of elements the stack can hold before growing.
+func (a *ArrayStack[T]) Capacity() int {
+    return cap(a.data)
+}
+
+// All walks the slice backwards, so the top of the stack comes first.
+func (a *ArrayStack[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for i := len(a.data) - 1; i >= 0; i-- {
+            if !yield(a.data[i]) {
+                return
+            }
+        }
+    }
+}
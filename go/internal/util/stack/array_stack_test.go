@@ -0,0 +1,127 @@
+package stack
+
+import (
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestArrayStack_LIFO checks the basic Stack contract
+func TestArrayStack_LIFO(t *testing.T) {
+    var stack Stack[int] = NewArrayStack[int](0)
+    assert.True(t, stack.IsEmpty())
+
+    _, ok := stack.Peek()
+    assert.False(t, ok, "Peek on empty stack should return false")
+    assert.Panics(t, func() {
+        stack.Pop()
+    }, "Empty stack should Panic")
+
+    for i := 1; i <= 3; i++ {
+        stack.Push(i)
+    }
+    assert.Equal(t, 3, stack.Size())
+
+    val, ok := stack.Peek()
+    assert.True(t, ok)
+    assert.Equal(t, 3, val)
+    assert.Equal(t, []int{3, 2, 1}, slices.Collect(stack.All()))
+
+    assert.Equal(t, 3, stack.Pop())
+    assert.Equal(t, 2, stack.Pop())
+    assert.Equal(t, 1, stack.Pop())
+    assert.True(t, stack.IsEmpty())
+}
+
+// TestArrayStack_Capacity tests the capacity hint and growth
+func TestArrayStack_Capacity(t *testing.T) {
+    assert.Equal(t, defaultCapacity, NewArrayStack[int](0).Capacity())
+
+    stack := NewArrayStack[int](4)
+    assert.Equal(t, 4, stack.Capacity())
+
+    for i := 0; i < 1000; i++ {
+        stack.Push(i)
+    }
+    assert.GreaterOrEqual(t, stack.Capacity(), 1000)
+
+    for i := 999; i >= 0; i-- {
+        assert.Equal(t, i, stack.Pop())
+    }
+    assert.GreaterOrEqual(t, stack.Capacity(), 1000, "NeverShrink should keep the capacity")
+}
+
+// TestArrayStack_ShrinkPolicy tests that the stack shrinks, but never below the initial capacity
+func TestArrayStack_ShrinkPolicy(t *testing.T) {
+    stack := NewArrayStackWithPolicy[int](8, ShrinkWhenQuarterFull)
+    for i := 0; i < 1024; i++ {
+        stack.Push(i)
+    }
+    grown := stack.Capacity()
+
+    for stack.Size() > 1 {
+        stack.Pop()
+    }
+    assert.Less(t, stack.Capacity(), grown, "Stack should shrink")
+    assert.GreaterOrEqual(t, stack.Capacity(), 8, "Stack should not shrink below the initial capacity")
+    assert.Less(t, stack.Capacity(), 16, "Stack should shrink down to the initial capacity")
+
+    // Contents are kept when shrinking
+    assert.Equal(t, 0, stack.Pop())
+
+    // A nil policy behaves as NeverShrink
+    assert.NotPanics(t, func() {
+        s := NewArrayStackWithPolicy[int](1, nil)
+        s.Push(1)
+        s.Push(2)
+        s.Pop()
+    })
+}
+
+// TestArrayStack_CustomShrinkPolicy tests that a policy asking to shrink a stack that is more
+// than half full is ignored, instead of truncating the backing slice
+func TestArrayStack_CustomShrinkPolicy(t *testing.T) {
+    eager := func(size, capacity int) bool {
+        return size <= capacity/2+1
+    }
+    stack := NewArrayStackWithPolicy[int](1, eager)
+    for i := 0; i < 8; i++ {
+        stack.Push(i)
+    }
+
+    for i := 7; i >= 0; i-- {
+        assert.Equal(t, i, stack.Pop())
+        assert.GreaterOrEqual(t, stack.Capacity(), stack.Size())
+    }
+    assert.True(t, stack.IsEmpty())
+}
+
+// TestStack_IgnoresNil checks that both implementations drop nil values the same way
+func TestStack_IgnoresNil(t *testing.T) {
+    stacks := map[string]Stack[any]{
+        "Array":            NewArrayStack[any](0),
+        "DoubleLinkedList": NewDoubleLinkedListStack[any](),
+    }
+    for name, stack := range stacks {
+        t.Run(name, func(t *testing.T) {
+            stack.Push(nil)
+            assert.True(t, stack.IsEmpty())
+
+            stack.Push(1)
+            stack.Push(nil)
+            assert.Equal(t, 1, stack.Size())
+            assert.Equal(t, 1, stack.Pop())
+            assert.True(t, stack.IsEmpty())
+        })
+    }
+}
+
+// TestArrayStack_ReleasesSlots tests that popped slots are zeroed, so pointers can be collected
+func TestArrayStack_ReleasesSlots(t *testing.T) {
+    stack := NewArrayStack[*int](2)
+    a := 1
+    stack.Push(&a)
+    stack.Pop()
+    assert.Nil(t, stack.data[:1][0])
+}
@@ -1,6 +1,10 @@
 package stack
 
-import "interview_go/internal/util/list"
+import (
+    "iter"
+
+    "interview_go/internal/util/list"
+)
 
 // DoubleLinkedListStack implementation of a Stack using the DoubleLinkedList
 type DoubleLinkedListStack[T any] struct {
@@ -22,11 +26,19 @@ func (d *DoubleLinkedListStack[T]) Push(t T) {
 }
 
 func (d *DoubleLinkedListStack[T]) Pop() T {
+    val, err := d.TryPop()
+    if err != nil {
+        panic(err)
+    }
+    return val
+}
+
+func (d *DoubleLinkedListStack[T]) TryPop() (T, error) {
     val, hasValue := d.stack.RemoveFromEnd()
-    if hasValue {
-        return val
+    if !hasValue {
+        return val, errEmpty
     }
-    panic("empty")
+    return val, nil
 }
 
 func (d *DoubleLinkedListStack[T]) Peek() (T, bool) {
@@ -40,3 +52,8 @@ func (d *DoubleLinkedListStack[T]) Size() int {
 func (d *DoubleLinkedListStack[T]) IsEmpty() bool {
     return d.stack.IsEmpty()
 }
+
+// All walks the underlying list backwards, so the top of the stack comes first.
+func (d *DoubleLinkedListStack[T]) All() iter.Seq[T] {
+    return d.stack.Backward()
+}
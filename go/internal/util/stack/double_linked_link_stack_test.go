@@ -1,8 +1,11 @@
 package stack
 
 import (
+    "slices"
     "testing"
 
+    "interview_go/internal/util/errs"
+
     "github.com/stretchr/testify/assert"
 )
 
@@ -248,3 +251,45 @@ func TestLargeStack(t *testing.T) {
         t.Error("Stack should be empty after popping all elements")
     }
 }
+
+// TestStackAll checks that ranging over the stack yields elements in pop order without removing them.
+func TestStackAll(t *testing.T) {
+    stack := NewDoubleLinkedListStack[int]()
+    stack.Push(1)
+    stack.Push(2)
+    stack.Push(3)
+
+    assert.Equal(t, []int{3, 2, 1}, slices.Collect(stack.All()))
+    assert.Equal(t, 3, stack.Size(), "All should not remove elements")
+
+    for range NewDoubleLinkedListStack[int]().All() {
+        t.Error("All on empty stack should not yield")
+    }
+}
+
+// TestTryPop checks the non-panicking Pop and the error wrapped by the Pop panic.
+func TestTryPop(t *testing.T) {
+    stacks := map[string]Stack[int]{
+        "DoubleLinkedList": NewDoubleLinkedListStack[int](),
+        "Array":            NewArrayStack[int](0),
+    }
+
+    for name, stack := range stacks {
+        t.Run(name, func(t *testing.T) {
+            _, err := stack.TryPop()
+            assert.ErrorIs(t, err, errs.ErrEmpty)
+
+            stack.Push(1)
+            val, err := stack.TryPop()
+            assert.NoError(t, err)
+            assert.Equal(t, 1, val)
+
+            defer func() {
+                err, ok := recover().(error)
+                assert.True(t, ok, "Pop should panic with an error")
+                assert.ErrorIs(t, err, errs.ErrEmpty)
+            }()
+            stack.Pop()
+        })
+    }
+}
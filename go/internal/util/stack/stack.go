@@ -1,13 +1,26 @@
 package stack
 
+import (
+    "fmt"
+    "iter"
+
+    "interview_go/internal/util/errs"
+)
+
 // Stack basic implementation of a stack
 type Stack[T any] interface {
-    // Push adds an element to the top of the Stack
+    // Push adds an element to the top of the Stack.
+    // A nil value (T is an interface type holding nil) is ignored, as in the list package.
     Push(T)
 
-    // Pop Retrieves the element from the top
+    // Pop Retrieves the element from the top.
+    // Panics with an error wrapping errs.ErrEmpty if the stack is empty.
     Pop() T
 
+    // TryPop is the non-panicking variant of Pop: returns an error wrapping errs.ErrEmpty
+    // if the stack is empty.
+    TryPop() (T, error)
+
     // Peek check element on top of the stack
     Peek() (T, bool)
 
@@ -16,4 +29,11 @@ type Stack[T any] interface {
 
     // IsEmpty Checks if the stack is empty
     IsEmpty() bool
+
+    // All returns a sequence over the elements from the top to the bottom of the stack (pop order),
+    // without removing them.
+    All() iter.Seq[T]
 }
+
+// errEmpty is the panic value (and TryPop error) for popping from an empty stack
+var errEmpty = fmt.Errorf("stack: %w", errs.ErrEmpty)
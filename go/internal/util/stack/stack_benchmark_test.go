@@ -0,0 +1,51 @@
+package stack
+
+import "testing"
+
+// Benchmarks comparing the Stack implementations.
+// Run with: go test -bench=. -benchmem ./internal/util/stack/
+
+var benchmarkStacks = []struct {
+    name    string
+    factory func() Stack[int]
+}{
+    {"DoubleLinkedList", func() Stack[int] { return NewDoubleLinkedListStack[int]() }},
+    {"Array", func() Stack[int] { return NewArrayStack[int](0) }},
+}
+
+// BenchmarkStack_PushPop pushes and pops n elements on a fresh stack
+func BenchmarkStack_PushPop(b *testing.B) {
+    const n = 1024
+    for _, impl := range benchmarkStacks {
+        b.Run(impl.name, func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                stack := impl.factory()
+                for j := 0; j < n; j++ {
+                    stack.Push(j)
+                }
+                for !stack.IsEmpty() {
+                    stack.Pop()
+                }
+            }
+        })
+    }
+}
+
+// BenchmarkStack_Reuse simulates a traversal stack: shallow push/pop cycles on the same stack
+func BenchmarkStack_Reuse(b *testing.B) {
+    for _, impl := range benchmarkStacks {
+        b.Run(impl.name, func(b *testing.B) {
+            b.ReportAllocs()
+            stack := impl.factory()
+            for i := 0; i < b.N; i++ {
+                for j := 0; j < 16; j++ {
+                    stack.Push(j)
+                }
+                for j := 0; j < 16; j++ {
+                    stack.Pop()
+                }
+            }
+        })
+    }
+}
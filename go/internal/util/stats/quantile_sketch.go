@@ -0,0 +1,165 @@
+package stats
+
+import (
+    "slices"
+)
+
+// defaultSketchCapacity is the level capacity of a QuantileSketch when no valid one is given
+const defaultSketchCapacity = 200
+
+// QuantileSketch estimates quantiles of an unbounded stream in O(k log(n/k)) memory.
+//
+// Values are kept in levels (compactors). A value at level h stands for 2^h values of the stream.
+// When a level reaches k values it is compacted: sorted, and every other value is promoted to the
+// next level, alternating between the odd and even positions so errors don't accumulate in one
+// direction:
+//
+//   level 0 (weight 1):  [2 3 5 7 8 9]  full, k = 6
+//   compact, keep odd:    . 3 . 7 . 9
+//   level 1 (weight 2):  [3 7 9]
+//
+// A quantile is answered by sorting all the kept values with their weights and walking the
+// cumulative weight. Each compaction shifts a rank by at most the weight of the level, so the rank
+// error grows with log(n/k)/k: k = 200 is typically within 1% of the exact rank.
+//
+// The exact minimum and maximum are tracked separately, for Quantile(0) and Quantile(1).
+type QuantileSketch[T Number] struct {
+    levels [][]T // levels[h] holds values of weight 2^h
+    k      int
+    count  int
+    min    T
+    max    T
+    odd    bool // Which half the next compaction keeps
+}
+
+// NewQuantileSketch creates an empty sketch, compacting a level when it holds k values.
+// Larger k is more accurate and uses more memory. k is rounded up to an even number, and a
+// value below 2 uses the default of 200.
+func NewQuantileSketch[T Number](k int) *QuantileSketch[T] {
+    if k < 2 {
+        k = defaultSketchCapacity
+    }
+    if k%2 != 0 {
+        k++
+    }
+    return &QuantileSketch[T]{levels: [][]T{make([]T, 0, k)}, k: k}
+}
+
+// compact promotes half of every full level to the next one
+func (s *QuantileSketch[T]) compact() {
+    for h := 0; h < len(s.levels) && len(s.levels[h]) >= s.k; h++ {
+        if h+1 == len(s.levels) {
+            s.levels = append(s.levels, make([]T, 0, s.k))
+        }
+        level := s.levels[h]
+        slices.Sort(level)
+
+        start := 0
+        if s.odd {
+            start = 1
+        }
+        s.odd = !s.odd
+        for i := start; i < len(level); i += 2 {
+            s.levels[h+1] = append(s.levels[h+1], level[i])
+        }
+        s.levels[h] = level[:0]
+    }
+}
+
+// Add inserts a value in the sketch.
+//
+// Time complexity: O(log k) amortized
+func (s *QuantileSketch[T]) Add(value T) {
+    if s.count == 0 || value < s.min {
+        s.min = value
+    }
+    if s.count == 0 || value > s.max {
+        s.max = value
+    }
+    s.count++
+    s.levels[0] = append(s.levels[0], value)
+    s.compact()
+}
+
+// weighted is a kept value with the number of stream values it stands for
+type weighted[T Number] struct {
+    value  T
+    weight int
+}
+
+// Quantile returns an estimate of the q-quantile, for q between 0 and 1: Quantile(0.5) is the
+// median, Quantile(0.99) the p99. Returns false if the sketch is empty.
+//
+// Time complexity: O(m log m), for m kept values
+func (s *QuantileSketch[T]) Quantile(q float64) (T, bool) {
+    values, ok := s.Quantiles(q)
+    if !ok {
+        return 0, false
+    }
+    return values[0], true
+}
+
+// Quantiles returns an estimate of several quantiles at once, sorting the kept values only once.
+// Returns false if the sketch is empty.
+func (s *QuantileSketch[T]) Quantiles(qs ...float64) ([]T, bool) {
+    if s.count == 0 {
+        return nil, false
+    }
+
+    var items []weighted[T]
+    for h, level := range s.levels {
+        for _, value := range level {
+            items = append(items, weighted[T]{value: value, weight: 1 << h})
+        }
+    }
+    slices.SortFunc(items, func(a, b weighted[T]) int {
+        switch {
+        case a.value < b.value:
+            return -1
+        case a.value > b.value:
+            return 1
+        }
+        return 0
+    })
+    total := 0
+    for _, item := range items {
+        total += item.weight
+    }
+
+    result := make([]T, len(qs))
+    for i, q := range qs {
+        switch {
+        case q <= 0:
+            result[i] = s.min
+        case q >= 1:
+            result[i] = s.max
+        default:
+            // First value whose cumulative weight passes q of the total
+            target := q * float64(total)
+            cumulative := 0
+            result[i] = s.max
+            for _, item := range items {
+                cumulative += item.weight
+                if float64(cumulative) > target {
+                    result[i] = item.value
+                    break
+                }
+            }
+        }
+    }
+    return result, true
+}
+
+// Count returns the number of values added.
+func (s *QuantileSketch[T]) Count() int {
+    return s.count
+}
+
+// Retained returns the number of values kept in memory.
+func (s *QuantileSketch[T]) Retained() int {
+    retained := 0
+    for _, level := range s.levels {
+        retained += len(level)
+    }
+    return retained
+}
@@ -0,0 +1,79 @@
+package stats
+
+import (
+    "math"
+    "math/rand"
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// TestQuantileSketch_Empty tests the sketch without values
+func TestQuantileSketch_Empty(t *testing.T) {
+    s := NewQuantileSketch[float64](0)
+    _, ok := s.Quantile(0.5)
+    assert.False(t, ok)
+    _, ok = s.Quantiles(0.5, 0.9)
+    assert.False(t, ok)
+    assert.Equal(t, 0, s.Count())
+}
+
+// TestQuantileSketch_Exact tests that below k values nothing is compacted, so quantiles are exact
+func TestQuantileSketch_Exact(t *testing.T) {
+    s := NewQuantileSketch[int](100)
+    for _, value := range []int{9, 1, 8, 2, 7, 3, 6, 4, 5, 10} {
+        s.Add(value)
+    }
+
+    quantiles, ok := s.Quantiles(0, 0.5, 0.9, 1)
+    assert.True(t, ok)
+    assert.Equal(t, []int{1, 6, 10, 10}, quantiles)
+    assert.Equal(t, 10, s.Retained())
+}
+
+// TestQuantileSketch_Capacity tests the rounding of k
+func TestQuantileSketch_Capacity(t *testing.T) {
+    assert.Equal(t, defaultSketchCapacity, NewQuantileSketch[int](-1).k)
+    assert.Equal(t, 4, NewQuantileSketch[int](3).k, "k should be rounded up to an even number")
+}
+
+// TestQuantileSketch_Accuracy tests the rank error of p50/p90/p99 on a large shuffled stream
+func TestQuantileSketch_Accuracy(t *testing.T) {
+    const n = 200000
+    stream := rand.New(rand.NewSource(1)).Perm(n)
+
+    s := NewQuantileSketch[int](200)
+    for _, value := range stream {
+        s.Add(value)
+    }
+    assert.Equal(t, n, s.Count())
+    assert.Less(t, s.Retained(), 200*int(math.Log2(n)), "memory should be O(k log(n/k))")
+
+    qs := []float64{0.5, 0.9, 0.99}
+    estimates, _ := s.Quantiles(qs...)
+    for i, q := range qs {
+        // The stream is a permutation of 0..n-1, so a value is its own rank
+        assert.InDelta(t, q*n, float64(estimates[i]), 0.01*n, "p%v", q*100)
+    }
+
+    low, _ := s.Quantile(0)
+    high, _ := s.Quantile(1)
+    assert.Equal(t, 0, low, "the minimum should be exact")
+    assert.Equal(t, n-1, high, "the maximum should be exact")
+}
+
+// TestQuantileSketch_SortedStream tests an adversarial, already sorted stream of floats
+func TestQuantileSketch_SortedStream(t *testing.T) {
+    const n = 50000
+    s := NewQuantileSketch[float64](200)
+    values := make([]float64, n)
+    for i := range values {
+        values[i] = float64(i) / 10
+        s.Add(values[i])
+    }
+
+    median, _ := s.Quantile(0.5)
+    index, _ := slices.BinarySearch(values, median)
+    assert.InDelta(t, n/2, index, 0.01*n)
+}
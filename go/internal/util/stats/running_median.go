@@ -0,0 +1,141 @@
+package stats
+
+import (
+    "cmp"
+
+    "interview_go/internal/util/heap"
+)
+
+// RunningMedian keeps the exact median of a stream of values.
+//
+// The values are split in two halves: the smaller half in a max-heap and the larger half in a
+// min-heap, so the median is always at the top of one or both heaps:
+//
+//   low (max-heap)        high (min-heap)
+//   [1, 3, 5] -> top 5    top 7 <- [7, 8, 9]
+//
+//   median = (5 + 7) / 2 = 6
+//
+// low holds the same number of values as high, or one more.
+//
+// Remove uses lazy deletion: a heap cannot remove an arbitrary element cheaply, so the value is
+// only recorded as deleted, and discarded when it reaches the top of its heap. The sizes count
+// the live values only. This makes sliding window medians O(log n) per step.
+type RunningMedian[T Number] struct {
+    low      *heap.ImplHeap[T] // Smaller half, largest on top
+    high     *heap.ImplHeap[T] // Larger half, smallest on top
+    lowSize  int               // Live values in low
+    highSize int               // Live values in high
+    live     map[T]int         // Multiplicity of every live value
+    deleted  map[T]int         // Values removed but still in a heap
+}
+
+// NewRunningMedian creates an empty RunningMedian.
+func NewRunningMedian[T Number]() *RunningMedian[T] {
+    return &RunningMedian[T]{
+        low:     heap.NewMaxHeap(cmp.Compare[T]),
+        high:    heap.NewMinHeap(cmp.Compare[T]),
+        live:    make(map[T]int),
+        deleted: make(map[T]int),
+    }
+}
+
+// prune discards the deleted values from the top of h, so the top is always live
+func (r *RunningMedian[T]) prune(h *heap.ImplHeap[T]) {
+    for {
+        top, ok := h.Peek()
+        if !ok || r.deleted[top] == 0 {
+            return
+        }
+        r.deleted[top]--
+        if r.deleted[top] == 0 {
+            delete(r.deleted, top)
+        }
+        h.Pop()
+    }
+}
+
+// rebalance moves the top of one heap to the other until low has as many live values as high,
+// or one more
+func (r *RunningMedian[T]) rebalance() {
+    if r.lowSize > r.highSize+1 {
+        value, _ := r.low.Pop()
+        r.high.Push(value)
+        r.lowSize--
+        r.highSize++
+        r.prune(r.low)
+    } else if r.lowSize < r.highSize {
+        value, _ := r.high.Pop()
+        r.low.Push(value)
+        r.highSize--
+        r.lowSize++
+        r.prune(r.high)
+    }
+}
+
+// Add inserts a value.
+//
+// Time complexity: O(log n)
+func (r *RunningMedian[T]) Add(value T) {
+    if top, ok := r.low.Peek(); !ok || value <= top {
+        r.low.Push(value)
+        r.lowSize++
+    } else {
+        r.high.Push(value)
+        r.highSize++
+    }
+    r.live[value]++
+    r.rebalance()
+}
+
+// Remove deletes one occurrence of value. Returns false if value was not added (or was
+// already removed as many times as it was added).
+//
+// Time complexity: O(log n) amortized
+func (r *RunningMedian[T]) Remove(value T) bool {
+    if r.live[value] == 0 {
+        return false
+    }
+    r.live[value]--
+    if r.live[value] == 0 {
+        delete(r.live, value)
+    }
+    r.deleted[value]++
+
+    // Every value in low is <= every value in high, so the side is known from the top of low
+    if top, _ := r.low.Peek(); value <= top {
+        r.lowSize--
+        r.prune(r.low)
+    } else {
+        r.highSize--
+        r.prune(r.high)
+    }
+    r.rebalance()
+    return true
+}
+
+// Median returns the median of the live values: the middle one for an odd count, the mean of
+// the two middle ones for an even count. Returns false if there are no values.
+//
+// Time complexity: O(1)
+func (r *RunningMedian[T]) Median() (float64, bool) {
+    if r.lowSize == 0 {
+        return 0, false
+    }
+    low, _ := r.low.Peek()
+    if r.lowSize > r.highSize {
+        return float64(low), true
+    }
+    high, _ := r.high.Peek()
+    return (float64(low) + float64(high)) / 2, true
+}
+
+// Size returns the number of live values.
+func (r *RunningMedian[T]) Size() int {
+    return r.lowSize + r.highSize
+}
+
+// IsEmpty returns true if there are no live values.
+func (r *RunningMedian[T]) IsEmpty() bool {
+    return r.Size() == 0
+}
@@ -0,0 +1,97 @@
+package stats
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// exactMedian sorts a copy of values and returns the middle (or the mean of the two middle ones)
+func exactMedian(values []int) float64 {
+    sorted := slices.Clone(values)
+    slices.Sort(sorted)
+    n := len(sorted)
+    if n%2 == 1 {
+        return float64(sorted[n/2])
+    }
+    return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+// TestRunningMedian_Empty tests the median of no values
+func TestRunningMedian_Empty(t *testing.T) {
+    r := NewRunningMedian[int]()
+    _, ok := r.Median()
+    assert.False(t, ok)
+    assert.True(t, r.IsEmpty())
+    assert.False(t, r.Remove(1), "Remove of a value never added should return false")
+}
+
+// TestRunningMedian_Add tests the median after every Add
+func TestRunningMedian_Add(t *testing.T) {
+    r := NewRunningMedian[int]()
+    expected := []float64{5, 10, 5, 6.5, 5}
+    for i, value := range []int{5, 15, 1, 8, 3} {
+        r.Add(value)
+        median, ok := r.Median()
+        assert.True(t, ok)
+        assert.Equal(t, expected[i], median, "median after %d values", i+1)
+    }
+    assert.Equal(t, 5, r.Size())
+}
+
+// TestRunningMedian_Float tests a floating point stream
+func TestRunningMedian_Float(t *testing.T) {
+    r := NewRunningMedian[float64]()
+    r.Add(1.5)
+    r.Add(-2.5)
+    median, _ := r.Median()
+    assert.Equal(t, -0.5, median)
+}
+
+// TestRunningMedian_Remove tests removals, including duplicates and values on both sides
+func TestRunningMedian_Remove(t *testing.T) {
+    r := NewRunningMedian[int]()
+    for _, value := range []int{4, 4, 4, 1, 9, 7} {
+        r.Add(value)
+    }
+    assert.True(t, r.Remove(4))
+    assert.True(t, r.Remove(9))
+    median, _ := r.Median()
+    assert.Equal(t, exactMedian([]int{4, 4, 1, 7}), median)
+
+    assert.True(t, r.Remove(4))
+    assert.True(t, r.Remove(4))
+    assert.False(t, r.Remove(4), "every 4 was already removed")
+    median, _ = r.Median()
+    assert.Equal(t, 4.0, median)
+
+    assert.True(t, r.Remove(1))
+    assert.True(t, r.Remove(7))
+    assert.True(t, r.IsEmpty())
+    _, ok := r.Median()
+    assert.False(t, ok)
+}
+
+// TestRunningMedian_SlidingWindow compares a sliding window median against sorting the window
+func TestRunningMedian_SlidingWindow(t *testing.T) {
+    rnd := rand.New(rand.NewSource(3))
+    stream := make([]int, 2000)
+    for i := range stream {
+        stream[i] = rnd.Intn(100)
+    }
+
+    const window = 31
+    r := NewRunningMedian[int]()
+    for i, value := range stream {
+        r.Add(value)
+        if i >= window {
+            assert.True(t, r.Remove(stream[i-window]))
+        }
+        start := max(0, i-window+1)
+        median, _ := r.Median()
+        assert.Equal(t, exactMedian(stream[start:i+1]), median, "window ending at %d", i)
+    }
+    assert.Equal(t, window, r.Size())
+}
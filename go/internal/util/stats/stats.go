@@ -0,0 +1,16 @@
+// Package stats provides streaming statistics over numeric values, built on the containers in
+// internal/util.
+//
+//   - RunningMedian: exact median of a stream (or sliding window), with a max-heap/min-heap pair.
+//   - QuantileSketch: approximate quantiles (p50, p90, p99, ...) of an unbounded stream, in
+//     bounded memory.
+package stats
+
+import (
+    "golang.org/x/exp/constraints"
+)
+
+// Number is any integer or floating point type.
+type Number interface {
+    constraints.Integer | constraints.Float
+}
@@ -0,0 +1,309 @@
+package tree
+
+import (
+    "cmp"
+    "iter"
+
+    "interview_go/internal/util/iterator"
+
+    "golang.org/x/exp/constraints"
+)
+
+// AVLTree is a self-balancing Binary Search Tree. Every node keeps the height of its subtree, and
+// the heights of the two subtrees of any node differ by at most one (the balance factor is -1, 0
+// or 1), so the height stays below 1.44 log2(n) and every operation is O(log n).
+//
+// After an Add or a Remove, the nodes on the path back to the root are rebalanced with rotations:
+//
+// Left-left case (balance factor 2, left child leaning left): rotate right
+//
+//          30              20
+//         /               /  \
+//       20       =>     10    30
+//      /
+//    10
+//
+// Left-right case (balance factor 2, left child leaning right): rotate the child left, then
+// rotate right
+//
+//        30              30              20
+//       /               /               /  \
+//     10       =>     20       =>     10    30
+//       \            /
+//        20        10
+//
+// The right-right and right-left cases are symmetrical.
+//
+// Sorted inserts, which turn a BinaryTree into a linked list, keep an AVLTree balanced:
+//
+//   Add(10, 20, 30, 40, 50)
+//
+//   BinaryTree:  10               AVLTree:      20
+//                  \                           /  \
+//                   20                       10    40
+//                     \                           /  \
+//                      ...                      30    50
+//
+type AVLTree[T constraints.Ordered] struct {
+    root *Node[T]
+    size int
+}
+
+// NewAVLTree creates and returns a new empty AVL tree.
+func NewAVLTree[T constraints.Ordered]() *AVLTree[T] {
+    return &AVLTree[T]{}
+}
+
+// Compile-time check to ensure AVLTree implements the Tree and OrderedTree interfaces
+var _ OrderedTree[string] = (*AVLTree[string])(nil)
+
+// height returns the height of a subtree, 0 for an empty one
+func height[T constraints.Ordered](node *Node[T]) int {
+    if node == nil {
+        return 0
+    }
+    return node.height
+}
+
+// balanceFactor returns the height of the left subtree minus the height of the right subtree
+func balanceFactor[T constraints.Ordered](node *Node[T]) int {
+    return height(node.left) - height(node.right)
+}
+
+// updateHeight recomputes the height and the size of a node from its children
+func updateHeight[T constraints.Ordered](node *Node[T]) {
+    node.height = 1 + max(height(node.left), height(node.right))
+    node.size = 1 + nodeSize(node.left) + nodeSize(node.right)
+}
+
+// rotateRight lifts the left child of node, and returns it as the new root of the subtree
+//
+//        node          left
+//        /  \          /  \
+//      left  c   =>   a   node
+//      /  \               /  \
+//     a    b             b    c
+func rotateRight[T constraints.Ordered](node *Node[T]) *Node[T] {
+    left := node.left
+    node.left = left.right
+    left.right = node
+    updateHeight(node)
+    updateHeight(left)
+    return left
+}
+
+// rotateLeft lifts the right child of node, and returns it as the new root of the subtree
+//
+//      node              right
+//      /  \              /  \
+//     a   right   =>   node  c
+//         /  \         /  \
+//        b    c       a    b
+func rotateLeft[T constraints.Ordered](node *Node[T]) *Node[T] {
+    right := node.right
+    node.right = right.left
+    right.left = node
+    updateHeight(node)
+    updateHeight(right)
+    return right
+}
+
+// rebalance restores the AVL property on node, whose subtrees are balanced but may differ in
+// height by two, and returns the new root of the subtree
+func rebalance[T constraints.Ordered](node *Node[T]) *Node[T] {
+    updateHeight(node)
+    switch factor := balanceFactor(node); {
+    case factor > 1:
+        if balanceFactor(node.left) < 0 {
+            // Left-right case
+            node.left = rotateLeft(node.left)
+        }
+        return rotateRight(node)
+    case factor < -1:
+        if balanceFactor(node.right) > 0 {
+            // Right-left case
+            node.right = rotateRight(node.right)
+        }
+        return rotateLeft(node)
+    }
+    return node
+}
+
+// Root returns the value at the root of the tree.
+// Returns the zero value and false if the tree is empty.
+func (a *AVLTree[T]) Root() (T, bool) {
+    if a.root == nil {
+        return *new(T), false
+    }
+    return a.root.value, true
+}
+
+// Add inserts a value, rebalancing the tree. Duplicates are ignored.
+//
+// Time complexity: O(log n)
+func (a *AVLTree[T]) Add(value T) {
+    var added bool
+    a.root, added = a.insert(a.root, value)
+    if added {
+        a.size++
+    }
+}
+
+// insert adds value to the subtree, returning its new root and whether the value was added
+func (a *AVLTree[T]) insert(node *Node[T], value T) (*Node[T], bool) {
+    if node == nil {
+        leaf := newNode(value)
+        leaf.height = 1
+        return leaf, true
+    }
+
+    var added bool
+    switch {
+    case value < node.value:
+        node.left, added = a.insert(node.left, value)
+    case value > node.value:
+        node.right, added = a.insert(node.right, value)
+    default:
+        return node, false
+    }
+    if !added {
+        // Nothing changed below: the heights are still valid
+        return node, false
+    }
+    return rebalance(node), true
+}
+
+// Search looks for a value in the tree.
+// Returns the value and true if found, otherwise returns zero value and false.
+//
+// Time complexity: O(log n)
+func (a *AVLTree[T]) Search(value T) (T, bool) {
+    node := a.root
+    for node != nil {
+        switch {
+        case value < node.value:
+            node = node.left
+        case value > node.value:
+            node = node.right
+        default:
+            return node.value, true
+        }
+    }
+    return *new(T), false
+}
+
+// Remove deletes a value, rebalancing the tree.
+// Returns the removed value and true if found, otherwise returns zero value and false.
+//
+// Time complexity: O(log n)
+func (a *AVLTree[T]) Remove(value T) (T, bool) {
+    var removed bool
+    a.root, removed = a.delete(a.root, value)
+    if !removed {
+        return *new(T), false
+    }
+    a.size--
+    return value, true
+}
+
+// delete removes value from the subtree, returning its new root and whether the value was found
+func (a *AVLTree[T]) delete(node *Node[T], value T) (*Node[T], bool) {
+    if node == nil {
+        return nil, false
+    }
+
+    var removed bool
+    switch {
+    case value < node.value:
+        node.left, removed = a.delete(node.left, value)
+    case value > node.value:
+        node.right, removed = a.delete(node.right, value)
+    default:
+        // At most one child: the child takes the node's place, and is already balanced
+        if node.left == nil {
+            return node.right, true
+        }
+        if node.right == nil {
+            return node.left, true
+        }
+        // Two children: take the value of the in-order successor, then remove the successor
+        successor := node.right
+        for successor.left != nil {
+            successor = successor.left
+        }
+        node.value = successor.value
+        node.right, _ = a.delete(node.right, successor.value)
+        removed = true
+    }
+    if !removed {
+        return node, false
+    }
+    return rebalance(node), true
+}
+
+// Clear removes all elements from the tree, leaving it empty.
+func (a *AVLTree[T]) Clear() {
+    a.root = nil
+    a.size = 0
+}
+
+// Size returns the number of elements in the tree.
+func (a *AVLTree[T]) Size() int {
+    return a.size
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf: 0 for an empty
+// tree, 1 for a single node. It is at most 1.44 log2(n+2).
+//
+// Time complexity: O(1)
+func (a *AVLTree[T]) Height() int {
+    return height(a.root)
+}
+
+// The ordered queries are documented on OrderedTree.
+
+func (a *AVLTree[T]) Min() (T, bool) {
+    return minNode(a.root)
+}
+
+func (a *AVLTree[T]) Max() (T, bool) {
+    return maxNode(a.root)
+}
+
+func (a *AVLTree[T]) Floor(value T) (T, bool) {
+    return below(a.root, value, true, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Ceiling(value T) (T, bool) {
+    return above(a.root, value, true, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Predecessor(value T) (T, bool) {
+    return below(a.root, value, false, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Successor(value T) (T, bool) {
+    return above(a.root, value, false, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Range(low, high T) Iterator[T] {
+    return newRangeIterator(a.root, low, high, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Rank(value T) int {
+    return rank(a.root, value, cmp.Compare[T])
+}
+
+func (a *AVLTree[T]) Select(k int) (T, bool) {
+    return selectKth(a.root, k)
+}
+
+// Iterator returns an in-order iterator, from the smallest to the largest value.
+func (a *AVLTree[T]) Iterator() Iterator[T] {
+    return newInOrderIterator(a.root)
+}
+
+// All returns the in-order traversal as a sequence, for use with range.
+func (a *AVLTree[T]) All() iter.Seq[T] {
+    return iterator.ToSeq(a.Iterator())
+}
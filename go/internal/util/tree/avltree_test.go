@@ -0,0 +1,205 @@
+package tree
+
+import (
+    "math"
+    "math/rand"
+    "slices"
+    "testing"
+    "testing/quick"
+)
+
+// checkAVL verifies the BST order, the stored heights and the balance factors of a subtree whose
+// values must be in (low, high), and returns its height and size
+func checkAVL(t *testing.T, node *Node[int], low, high int) (int, int) {
+    t.Helper()
+    if node == nil {
+        return 0, 0
+    }
+    if node.value <= low || node.value >= high {
+        t.Fatalf("Node %d is outside (%d, %d): BST order broken", node.value, low, high)
+    }
+    leftHeight, leftSize := checkAVL(t, node.left, low, node.value)
+    rightHeight, rightSize := checkAVL(t, node.right, node.value, high)
+
+    if factor := leftHeight - rightHeight; factor < -1 || factor > 1 {
+        t.Fatalf("Node %d has balance factor %d", node.value, factor)
+    }
+    h := 1 + max(leftHeight, rightHeight)
+    if node.height != h {
+        t.Fatalf("Node %d stores height %d, actual %d", node.value, node.height, h)
+    }
+    return h, 1 + leftSize + rightSize
+}
+
+// assertAVL checks the invariants of the whole tree and its size
+func assertAVL(t *testing.T, tree *AVLTree[int]) {
+    t.Helper()
+    h, size := checkAVL(t, tree.root, math.MinInt, math.MaxInt)
+    if size != tree.Size() {
+        t.Fatalf("Size() is %d, but the tree holds %d nodes", tree.Size(), size)
+    }
+    if h != tree.Height() {
+        t.Fatalf("Height() is %d, actual %d", tree.Height(), h)
+    }
+}
+
+// TestAVLTreeEmpty tests the empty tree.
+func TestAVLTreeEmpty(t *testing.T) {
+    tree := NewAVLTree[int]()
+    if _, ok := tree.Root(); ok {
+        t.Error("Root() on empty tree should return false")
+    }
+    if tree.Height() != 0 || tree.Size() != 0 {
+        t.Errorf("Expected height 0 and size 0, got %d and %d", tree.Height(), tree.Size())
+    }
+    if _, ok := tree.Remove(1); ok {
+        t.Error("Remove() on empty tree should return false")
+    }
+    if tree.Iterator().HasNext() {
+        t.Error("HasNext() on empty tree should be false")
+    }
+}
+
+// TestAVLTreeRotations tests the four rebalancing cases on three nodes: the middle value always
+// ends up at the root.
+func TestAVLTreeRotations(t *testing.T) {
+    cases := map[string][]int{
+        "LeftLeft":   {30, 20, 10},
+        "LeftRight":  {30, 10, 20},
+        "RightRight": {10, 20, 30},
+        "RightLeft":  {10, 30, 20},
+    }
+    for name, values := range cases {
+        t.Run(name, func(t *testing.T) {
+            tree := NewAVLTree[int]()
+            for _, v := range values {
+                tree.Add(v)
+            }
+            if root, _ := tree.Root(); root != 20 {
+                t.Errorf("Expected root 20, got %d", root)
+            }
+            if tree.Height() != 2 {
+                t.Errorf("Expected height 2, got %d", tree.Height())
+            }
+            assertAVL(t, tree)
+        })
+    }
+}
+
+// TestAVLTreeSortedInserts tests that the sorted inserts that skew a BinaryTree keep an AVLTree
+// logarithmic.
+func TestAVLTreeSortedInserts(t *testing.T) {
+    const count = 1 << 12
+    ascending, descending := NewAVLTree[int](), NewAVLTree[int]()
+    for i := 0; i < count; i++ {
+        ascending.Add(i)
+        descending.Add(count - i)
+    }
+
+    bound := int(1.44 * math.Log2(count+2))
+    for _, tree := range []*AVLTree[int]{ascending, descending} {
+        assertAVL(t, tree)
+        if tree.Height() > bound {
+            t.Errorf("Height %d exceeds the AVL bound %d", tree.Height(), bound)
+        }
+        if got := slices.Collect(tree.All()); !slices.IsSorted(got) || len(got) != count {
+            t.Errorf("In-order traversal should return %d sorted values", count)
+        }
+    }
+}
+
+// TestAVLTreeDuplicates tests that duplicates are not added.
+func TestAVLTreeDuplicates(t *testing.T) {
+    tree := NewAVLTree[string]()
+    for _, v := range []string{"dog", "cat", "dog", "ant", "cat"} {
+        tree.Add(v)
+    }
+    expected := []string{"ant", "cat", "dog"}
+    if got := slices.Collect(tree.All()); !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+    if tree.Size() != 3 {
+        t.Errorf("Expected size 3, got %d", tree.Size())
+    }
+}
+
+// TestAVLTreeRemove tests removing leaves, nodes with one child and with two children.
+func TestAVLTreeRemove(t *testing.T) {
+    tree := NewAVLTree[int]()
+    for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 10} {
+        tree.Add(v)
+    }
+
+    for _, v := range []int{10, 20, 50, 70} { // Leaf, one child, root with two children, two children
+        if got, ok := tree.Remove(v); !ok || got != v {
+            t.Errorf("Remove(%d) expected (%d, true), got (%d, %v)", v, v, got, ok)
+        }
+        if _, ok := tree.Search(v); ok {
+            t.Errorf("Search(%d) should fail after Remove", v)
+        }
+        assertAVL(t, tree)
+    }
+    if _, ok := tree.Remove(50); ok {
+        t.Error("Remove() of a missing value should return false")
+    }
+
+    expected := []int{30, 40, 60, 80}
+    if got := slices.Collect(tree.All()); !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+}
+
+// TestAVLTreeRemoveRebalances tests that removing from the short side rotates the tree.
+func TestAVLTreeRemoveRebalances(t *testing.T) {
+    tree := NewAVLTree[int]()
+    for _, v := range []int{20, 10, 30, 40} {
+        tree.Add(v)
+    }
+    //     20                  30
+    //    /  \                /  \
+    //   10   30     =>     20    40
+    //          \
+    //           40
+    tree.Remove(10)
+    if root, _ := tree.Root(); root != 30 {
+        t.Errorf("Expected root 30 after rebalancing, got %d", root)
+    }
+    assertAVL(t, tree)
+}
+
+// TestAVLTreeProperties is a property-based test: any sequence of adds and removes keeps the AVL
+// invariants, and the tree holds the same values as a reference set.
+func TestAVLTreeProperties(t *testing.T) {
+    property := func(ops []int8) bool {
+        tree := NewAVLTree[int]()
+        reference := map[int]bool{}
+
+        for _, op := range ops {
+            // Small values so that removes and duplicates hit existing nodes
+            value := int(op) / 4
+            if op%2 == 0 {
+                tree.Add(value)
+                reference[value] = true
+            } else {
+                _, removed := tree.Remove(value)
+                if removed != reference[value] {
+                    return false
+                }
+                delete(reference, value)
+            }
+            assertAVL(t, tree)
+        }
+
+        expected := make([]int, 0, len(reference))
+        for v := range reference {
+            expected = append(expected, v)
+        }
+        slices.Sort(expected)
+        return slices.Equal(slices.Collect(tree.All()), expected)
+    }
+
+    config := &quick.Config{MaxCount: 500, Rand: rand.New(rand.NewSource(42))}
+    if err := quick.Check(property, config); err != nil {
+        t.Error(err)
+    }
+}
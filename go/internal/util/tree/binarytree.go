@@ -1,6 +1,10 @@
 package tree
 
 import (
+    "cmp"
+    "iter"
+
+    "interview_go/internal/util/iterator"
     "interview_go/internal/util/stack"
 
     "golang.org/x/exp/constraints"
@@ -15,16 +19,20 @@ import (
 //       /    \
 //    [30]    [70]
 //
-type Node[T constraints.Ordered] struct {
-    value T
-    left  *Node[T]
-    right *Node[T]
+// The balanced trees reuse the same node, each maintaining its own balancing field.
+type Node[T any] struct {
+    value  T
+    left   *Node[T]
+    right  *Node[T]
+    size   int  // Number of nodes in the subtree rooted here, maintained by every tree
+    height int  // Height of the subtree rooted here, a leaf is 1. Only maintained by AVLTree
+    red    bool // Color of the link from the parent. Only maintained by RedBlackTree
 }
 
 // newNode creates and returns a new node with the given value.
 // The left and right children are initialized to nil.
-func newNode[T constraints.Ordered](value T) *Node[T] {
-    return &Node[T]{value: value}
+func newNode[T any](value T) *Node[T] {
+    return &Node[T]{value: value, size: 1}
 }
 
 // BinaryTree is a generic Binary Search Tree implementation.
@@ -55,14 +63,14 @@ func NewBinaryTree[T constraints.Ordered]() *BinaryTree[T] {
     return &BinaryTree[T]{}
 }
 
-// Compile-time check to ensure BinaryTree implements the Tree interface
-var _ Tree[string] = (*BinaryTree[string])(nil)
+// Compile-time check to ensure BinaryTree implements the Tree and OrderedTree interfaces
+var _ OrderedTree[string] = (*BinaryTree[string])(nil)
 
 // Root returns the value at the root of the tree.
 // Returns the zero value and false if the tree is empty, otherwise returns the root value and true.
 func (b *BinaryTree[T]) Root() (T, bool) {
     if b.root == nil {
-        return *new(T), true
+        return *new(T), false
     }
     return b.root.value, true
 }
@@ -92,31 +100,34 @@ func (b *BinaryTree[T]) Root() (T, bool) {
 // In a balanced tree: O(log n), in worst case (skewed): O(n)
 //
 func (b *BinaryTree[T]) Add(value T) {
-    // Commits the size before adding the value
-    b.size += 1
-
     // Special case, empty tree
     if b.root == nil {
         b.root = newNode(value)
+        b.size = 1
+        return
+    }
+
+    // Special case - value already exists
+    if _, found := b.Search(value); found {
         return
     }
 
     node := b.root
     for {
-        // Special case - value already exists
-        if node.value == value {
-            return
-        }
+        // The new node goes below: one more node in this subtree
+        node.size += 1
 
         if value < node.value {
             if node.left == nil {
                 node.left = newNode(value)
+                b.size += 1
                 return
             }
             node = node.left
         } else {
             if node.right == nil {
                 node.right = newNode(value)
+                b.size += 1
                 return
             }
             node = node.right
@@ -152,9 +163,9 @@ func (b *BinaryTree[T]) Search(value T) (T, bool) {
         }
 
         if value < node.value {
-            node = b.root.left
+            node = node.left
         } else {
-            node = b.root.right
+            node = node.right
         }
 
         if node == nil {
@@ -215,10 +226,21 @@ func (b *BinaryTree[T]) Remove(value T) (T, bool) {
         return *new(T), false
     }
 
-    // Cases where a leaf is empty
+    // Every node on the path loses one node in its subtree
+    for n := b.root; n != node; {
+        n.size -= 1
+        if value < n.value {
+            n = n.left
+        } else {
+            n = n.right
+        }
+    }
+    node.size -= 1
+
+    // Cases where at most one child exists: the child, if any, takes the node's place
     b.size -= 1
     var child *Node[T] = nil
-    if node.left == nil && node.right == nil {
+    if node.left == nil || node.right == nil {
 
         if node.left == nil {
             child = node.right
@@ -267,6 +289,7 @@ func (b *BinaryTree[T]) Remove(value T) (T, bool) {
         successor := node.right
         parent = node // can be root
         for successor.left != nil {
+            successor.size -= 1
             parent = successor
             successor = successor.left
         }
@@ -275,13 +298,12 @@ func (b *BinaryTree[T]) Remove(value T) (T, bool) {
         // to use references or pointers
         node.value = successor.value
 
-        // Now delete successor
-        if parent.left != nil && parent.left.value == successor.value {
-            // Replace a parent's left child with a successor's right child
-            parent.left = successor.right
-        } else {
+        // Now delete successor, replacing it with its right child
+        if parent == node {
+            // The successor is the node's right child
             parent.right = successor.right
-
+        } else {
+            parent.left = successor.right
         }
     }
 
@@ -296,6 +318,49 @@ func (b *BinaryTree[T]) Clear() {
     b.size = 0
 }
 
+// Size returns the number of elements in the tree.
+func (b *BinaryTree[T]) Size() int {
+    return b.size
+}
+
+// The ordered queries are documented on OrderedTree.
+
+func (b *BinaryTree[T]) Min() (T, bool) {
+    return minNode(b.root)
+}
+
+func (b *BinaryTree[T]) Max() (T, bool) {
+    return maxNode(b.root)
+}
+
+func (b *BinaryTree[T]) Floor(value T) (T, bool) {
+    return below(b.root, value, true, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Ceiling(value T) (T, bool) {
+    return above(b.root, value, true, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Predecessor(value T) (T, bool) {
+    return below(b.root, value, false, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Successor(value T) (T, bool) {
+    return above(b.root, value, false, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Range(low, high T) Iterator[T] {
+    return newRangeIterator(b.root, low, high, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Rank(value T) int {
+    return rank(b.root, value, cmp.Compare[T])
+}
+
+func (b *BinaryTree[T]) Select(k int) (T, bool) {
+    return selectKth(b.root, k)
+}
+
 // Iterator returns an iterator for traversing the tree elements.
 // The traversal order depends on the implementation (typically in-order for BST).
 //
@@ -313,16 +378,65 @@ func (b *BinaryTree[T]) Iterator() Iterator[T] {
     return newInOrderIterator(b.root)
 }
 
+// All returns the in-order traversal as a sequence, for use with range.
+//
+//  for v := range tree.All() {
+//      fmt.Println(v) // 20, 30, 40, 50, 60, 70, 80
+//  }
+func (b *BinaryTree[T]) All() iter.Seq[T] {
+    return iterator.ToSeq(b.Iterator())
+}
+
+// PreOrderIterator returns an iterator visiting each node before its left and right subtrees.
+// Re-adding the values in this order to an empty BinaryTree rebuilds the same tree.
+//
+// Iterator order for the tree above: 50, 30, 20, 40, 70, 60, 80
+func (b *BinaryTree[T]) PreOrderIterator() Iterator[T] {
+    return newPreOrderIterator(b.root)
+}
+
+// PostOrderIterator returns an iterator visiting the left and right subtrees of each node before
+// the node itself.
+//
+// Iterator order for the tree above: 20, 40, 30, 60, 80, 70, 50
+func (b *BinaryTree[T]) PostOrderIterator() Iterator[T] {
+    return newPostOrderIterator(b.root)
+}
+
+// LevelOrderIterator returns an iterator visiting the tree level by level, from left to right.
+// It uses a queue of up to the width of the tree, instead of a stack of its height.
+//
+// Iterator order for the tree above: 50, 30, 70, 20, 40, 60, 80
+func (b *BinaryTree[T]) LevelOrderIterator() Iterator[T] {
+    return newLevelOrderIterator(b.root)
+}
+
+// ReverseInOrderIterator returns an iterator from the largest to the smallest value.
+//
+// Iterator order for the tree above: 80, 70, 60, 50, 40, 30, 20
+func (b *BinaryTree[T]) ReverseInOrderIterator() Iterator[T] {
+    return newReverseInOrderIterator(b.root)
+}
+
+// MorrisIterator returns an in-order iterator using O(1) extra space, instead of the O(h) stack
+// of Iterator. The tree is temporarily modified during the iteration: see MorrisIterator for the
+// rules.
+func (b *BinaryTree[T]) MorrisIterator() *MorrisIterator[T] {
+    return newMorrisIterator(b.root)
+}
+
 // inOrderIterator implements an in-order tree traversal using a stack.
 // It visits nodes in the order: left subtree, root, right subtree.
-type inOrderIterator[T constraints.Ordered] struct {
+// The stack is array-backed: it never holds more than h nodes, so after the first
+// descent Push no longer allocates.
+type inOrderIterator[T any] struct {
     stack stack.Stack[*Node[T]]
 }
 
 // newInOrderIterator creates a new in-order iterator starting from the given root node.
-func newInOrderIterator[T constraints.Ordered](root *Node[T]) Iterator[T] {
+func newInOrderIterator[T any](root *Node[T]) Iterator[T] {
     it := &inOrderIterator[T]{
-        stack: stack.NewDoubleLinkedListStack[*Node[T]](),
+        stack: stack.NewArrayStack[*Node[T]](0),
     }
     it.pushLeft(root)
     return it
@@ -357,7 +471,7 @@ func (it *inOrderIterator[T]) HasNext() bool {
 }
 
 // Next returns the next element in the in-order traversal.
-// Panics if called when there are no remaining elements.
+// Panics with an error wrapping errs.ErrExhausted if called when there are no remaining elements.
 //
 // Algorithm explanation:
 //
@@ -397,7 +511,7 @@ func (it *inOrderIterator[T]) HasNext() bool {
 func (it *inOrderIterator[T]) Next() T {
     if it.stack.IsEmpty() {
         // Follow the classic iterator contract
-        panic("iterator has no more elements")
+        panic(errExhausted)
     }
 
     node := it.stack.Pop()
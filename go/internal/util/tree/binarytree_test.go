@@ -1,7 +1,12 @@
 package tree
 
 import (
+    "errors"
+    "slices"
     "testing"
+
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/iterator"
 )
 
 // TestInOrderIteratorBasic tests basic iterator functionality with a simple tree.
@@ -408,3 +413,159 @@ func TestInOrderIteratorLargeTree(t *testing.T) {
         }
     })
 }
+
+// TestAllRangeOverFunc tests ranging over the tree with the stdlib helpers.
+func TestAllRangeOverFunc(t *testing.T) {
+    tree := NewBinaryTree[int]()
+    for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+        tree.Add(v)
+    }
+
+    t.Run("InOrder", func(t *testing.T) {
+        expected := []int{20, 30, 40, 50, 60, 70, 80}
+        if got := slices.Collect(tree.All()); !slices.Equal(got, expected) {
+            t.Errorf("All() expected %v, got %v", expected, got)
+        }
+    })
+
+    t.Run("Break", func(t *testing.T) {
+        count := 0
+        for v := range tree.All() {
+            if v > 40 {
+                break
+            }
+            count++
+        }
+        if count != 3 {
+            t.Errorf("Expected 3 elements before break, got %d", count)
+        }
+    })
+}
+
+// TestInOrderIteratorWithCombinators tests chaining lazy combinators over the in-order iterator.
+func TestInOrderIteratorWithCombinators(t *testing.T) {
+    tree := NewBinaryTree[int]()
+    for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+        tree.Add(v)
+    }
+
+    over30 := iterator.Filter(tree.Iterator(), func(v int) bool { return v > 30 })
+    got := iterator.Collect(iterator.Take(over30, 3))
+
+    expected := []int{40, 50, 60}
+    if !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+}
+
+// TestInOrderIteratorPanicWrapsSentinel tests that the exhausted panic can be matched with errors.Is.
+func TestInOrderIteratorPanicWrapsSentinel(t *testing.T) {
+    it := NewBinaryTree[int]().Iterator()
+
+    defer func() {
+        err, ok := recover().(error)
+        if !ok || !errors.Is(err, errs.ErrExhausted) {
+            t.Errorf("Expected a panic wrapping ErrExhausted, got %v", err)
+        }
+    }()
+    it.Next()
+}
+
+// TestSearch tests finding values at every depth, and missing values.
+func TestSearch(t *testing.T) {
+    tree := NewBinaryTree[int]()
+    for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+        tree.Add(v)
+    }
+
+    for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+        if got, ok := tree.Search(v); !ok || got != v {
+            t.Errorf("Search(%d) expected (%d, true), got (%d, %v)", v, v, got, ok)
+        }
+    }
+    for _, v := range []int{10, 35, 65, 90} {
+        if _, ok := tree.Search(v); ok {
+            t.Errorf("Search(%d) should not find a value", v)
+        }
+    }
+}
+
+// TestRootEmptyTree tests that Root reports an empty tree.
+func TestRootEmptyTree(t *testing.T) {
+    tree := NewBinaryTree[int]()
+    if _, ok := tree.Root(); ok {
+        t.Error("Root() on empty tree should return false")
+    }
+
+    tree.Add(10)
+    if root, ok := tree.Root(); !ok || root != 10 {
+        t.Errorf("Root() expected (10, true), got (%d, %v)", root, ok)
+    }
+}
+
+// TestAddDuplicatesKeepSize tests that adding an existing value does not change the size.
+func TestAddDuplicatesKeepSize(t *testing.T) {
+    tree := NewBinaryTree[int]()
+    for _, v := range []int{50, 30, 70, 50, 30, 70} {
+        tree.Add(v)
+    }
+    if tree.size != 3 {
+        t.Errorf("size expected 3 after adding duplicates, got %d", tree.size)
+    }
+}
+
+// TestRemove tests the three removal cases, checking the remaining values and the size.
+func TestRemove(t *testing.T) {
+    // Tree structure:
+    //            50
+    //         /     \
+    //       30       70
+    //      /  \     /  \
+    //    20   40  60    80
+    //              \
+    //              65
+    values := []int{50, 30, 70, 20, 40, 60, 80, 65}
+
+    tests := []struct {
+        name     string
+        remove   int
+        expected []int
+    }{
+        {"Leaf", 20, []int{30, 40, 50, 60, 65, 70, 80}},
+        {"OneRightChild", 60, []int{20, 30, 40, 50, 65, 70, 80}},
+        {"TwoChildrenSuccessorIsRightChild", 30, []int{20, 40, 50, 60, 65, 70, 80}},
+        {"TwoChildrenSuccessorDeeper", 50, []int{20, 30, 40, 60, 65, 70, 80}},
+        {"TwoChildrenSuccessorHasRightChild", 70, []int{20, 30, 40, 50, 60, 65, 80}},
+        {"Missing", 55, []int{20, 30, 40, 50, 60, 65, 70, 80}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            tree := NewBinaryTree[int]()
+            for _, v := range values {
+                tree.Add(v)
+            }
+
+            _, ok := tree.Remove(tt.remove)
+            if ok != (len(tt.expected) < len(values)) {
+                t.Errorf("Remove(%d) returned %v", tt.remove, ok)
+            }
+            if got := slices.Collect(tree.All()); !slices.Equal(got, tt.expected) {
+                t.Errorf("after Remove(%d) expected %v, got %v", tt.remove, tt.expected, got)
+            }
+            if tree.size != len(tt.expected) {
+                t.Errorf("size expected %d, got %d", len(tt.expected), tree.size)
+            }
+        })
+    }
+
+    t.Run("OneLeftChildAtRoot", func(t *testing.T) {
+        tree := NewBinaryTree[int]()
+        tree.Add(50)
+        tree.Add(30)
+        tree.Remove(50)
+        if root, ok := tree.Root(); !ok || root != 30 {
+            t.Errorf("Root() after removing the root expected (30, true), got (%d, %v)", root, ok)
+        }
+    })
+}
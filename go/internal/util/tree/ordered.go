@@ -0,0 +1,222 @@
+package tree
+
+import (
+    "interview_go/internal/util/stack"
+)
+
+// OrderedTree is a Tree that keeps its values sorted, and answers ordered queries.
+//
+// Example, for this tree:
+//
+//             50
+//            /  \
+//          30    70
+//         /  \     \
+//       20    40    80
+//
+//   Min() = 20, Max() = 80
+//   Floor(45) = 40, Ceiling(45) = 50      // Closest values at or below, at or above
+//   Predecessor(50) = 40, Successor(50) = 70 // Closest values strictly below, strictly above
+//   Range(35, 70) -> 40, 50, 70
+//   Rank(50) = 3                          // Number of values below 50
+//   Select(3) = 50                        // 0-based: Select(Rank(v)) = v
+//
+// Every node stores the size of its subtree, so that Rank and Select only walk one path.
+// All queries are O(h), where h is the height: O(log n) for the balanced trees.
+type OrderedTree[T any] interface {
+    Tree[T]
+
+    // Size returns the number of elements in the tree.
+    Size() int
+
+    // Min returns the smallest value, and false if the tree is empty.
+    Min() (T, bool)
+
+    // Max returns the largest value, and false if the tree is empty.
+    Max() (T, bool)
+
+    // Floor returns the largest value less than or equal to value, and false if there is none.
+    Floor(value T) (T, bool)
+
+    // Ceiling returns the smallest value greater than or equal to value, and false if there is none.
+    Ceiling(value T) (T, bool)
+
+    // Predecessor returns the largest value strictly less than value, and false if there is none.
+    // value does not need to be in the tree.
+    Predecessor(value T) (T, bool)
+
+    // Successor returns the smallest value strictly greater than value, and false if there is none.
+    // value does not need to be in the tree.
+    Successor(value T) (T, bool)
+
+    // Range returns an in-order iterator over the values in [low, high], both included.
+    // Subtrees outside the range are never visited: creating the iterator is O(h), and every
+    // value returned costs O(1) amortized.
+    Range(low, high T) Iterator[T]
+
+    // Rank returns the number of values strictly less than value, which does not need to be in
+    // the tree. For a value in the tree, it is its 0-based position in sorted order.
+    Rank(value T) int
+
+    // Select returns the k-th smallest value (0-based), and false if k is outside [0, Size()).
+    Select(k int) (T, bool)
+}
+
+// nodeSize returns the size of a subtree, 0 for an empty one
+func nodeSize[T any](node *Node[T]) int {
+    if node == nil {
+        return 0
+    }
+    return node.size
+}
+
+// minNode returns the leftmost value of a subtree
+func minNode[T any](node *Node[T]) (T, bool) {
+    if node == nil {
+        return *new(T), false
+    }
+    for node.left != nil {
+        node = node.left
+    }
+    return node.value, true
+}
+
+// maxNode returns the rightmost value of a subtree
+func maxNode[T any](node *Node[T]) (T, bool) {
+    if node == nil {
+        return *new(T), false
+    }
+    for node.right != nil {
+        node = node.right
+    }
+    return node.value, true
+}
+
+// below returns the largest value less than value (or equal, if inclusive). Each time the search
+// goes right, the current node is the best candidate so far.
+func below[T any](node *Node[T], value T, inclusive bool, cmp func(a, b T) int) (T, bool) {
+    var best *Node[T]
+    for node != nil {
+        c := cmp(node.value, value)
+        if c == 0 && inclusive {
+            return node.value, true
+        }
+        if c < 0 {
+            best = node
+            node = node.right
+        } else {
+            node = node.left
+        }
+    }
+    if best == nil {
+        return *new(T), false
+    }
+    return best.value, true
+}
+
+// above returns the smallest value greater than value (or equal, if inclusive)
+func above[T any](node *Node[T], value T, inclusive bool, cmp func(a, b T) int) (T, bool) {
+    var best *Node[T]
+    for node != nil {
+        c := cmp(node.value, value)
+        if c == 0 && inclusive {
+            return node.value, true
+        }
+        if c > 0 {
+            best = node
+            node = node.left
+        } else {
+            node = node.right
+        }
+    }
+    if best == nil {
+        return *new(T), false
+    }
+    return best.value, true
+}
+
+// rank counts the values less than value: every time the search goes right, the node and its
+// left subtree are all smaller
+func rank[T any](node *Node[T], value T, cmp func(a, b T) int) int {
+    count := 0
+    for node != nil {
+        c := cmp(value, node.value)
+        switch {
+        case c < 0:
+            node = node.left
+        case c > 0:
+            count += nodeSize(node.left) + 1
+            node = node.right
+        default:
+            return count + nodeSize(node.left)
+        }
+    }
+    return count
+}
+
+// selectKth returns the k-th smallest value of a subtree, using the size of the left subtrees to
+// pick a side
+func selectKth[T any](node *Node[T], k int) (T, bool) {
+    if k < 0 || k >= nodeSize(node) {
+        return *new(T), false
+    }
+    for {
+        left := nodeSize(node.left)
+        switch {
+        case k < left:
+            node = node.left
+        case k > left:
+            k -= left + 1
+            node = node.right
+        default:
+            return node.value, true
+        }
+    }
+}
+
+// rangeIterator is an in-order iterator restricted to [low, high]. It works like
+// inOrderIterator, but skips the left subtrees of nodes below low on the way down, and stops at
+// the first value above high.
+type rangeIterator[T any] struct {
+    stack stack.Stack[*Node[T]]
+    low   T
+    high  T
+    cmp   func(a, b T) int
+}
+
+// newRangeIterator creates an iterator over the values of the subtree in [low, high]
+func newRangeIterator[T any](root *Node[T], low, high T, cmp func(a, b T) int) Iterator[T] {
+    it := &rangeIterator[T]{stack: stack.NewArrayStack[*Node[T]](0), low: low, high: high, cmp: cmp}
+    it.pushLeft(root)
+    return it
+}
+
+// Compile-time check to ensure rangeIterator implements the Iterator interface
+var _ Iterator[int] = (*rangeIterator[int])(nil)
+
+// pushLeft pushes the leftmost path of the values at least low
+func (it *rangeIterator[T]) pushLeft(node *Node[T]) {
+    for node != nil {
+        if it.cmp(node.value, it.low) < 0 {
+            // The node and its left subtree are below the range
+            node = node.right
+            continue
+        }
+        it.stack.Push(node)
+        node = node.left
+    }
+}
+
+func (it *rangeIterator[T]) HasNext() bool {
+    top, ok := it.stack.Peek()
+    return ok && it.cmp(top.value, it.high) <= 0
+}
+
+func (it *rangeIterator[T]) Next() T {
+    if !it.HasNext() {
+        panic(errExhausted)
+    }
+    node := it.stack.Pop()
+    it.pushLeft(node.right)
+    return node.value
+}
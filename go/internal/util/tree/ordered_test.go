@@ -0,0 +1,203 @@
+package tree
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+)
+
+// TestOrderedQueriesExample tests every query on the tree of the OrderedTree example.
+func TestOrderedQueriesExample(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for _, v := range []int{50, 30, 70, 20, 40, 80} {
+                tree.Add(v)
+            }
+
+            queries := []struct {
+                name     string
+                query    func(int) (int, bool)
+                arg      int
+                expected int
+                ok       bool
+            }{
+                {"Floor", tree.Floor, 45, 40, true},
+                {"Floor", tree.Floor, 50, 50, true},
+                {"Floor", tree.Floor, 10, 0, false},
+                {"Ceiling", tree.Ceiling, 45, 50, true},
+                {"Ceiling", tree.Ceiling, 80, 80, true},
+                {"Ceiling", tree.Ceiling, 90, 0, false},
+                {"Predecessor", tree.Predecessor, 50, 40, true},
+                {"Predecessor", tree.Predecessor, 45, 40, true},
+                {"Predecessor", tree.Predecessor, 20, 0, false},
+                {"Successor", tree.Successor, 50, 70, true},
+                {"Successor", tree.Successor, 10, 20, true},
+                {"Successor", tree.Successor, 80, 0, false},
+                {"Select", tree.Select, 3, 50, true},
+                {"Select", tree.Select, 0, 20, true},
+                {"Select", tree.Select, 6, 0, false},
+                {"Select", tree.Select, -1, 0, false},
+            }
+            for _, q := range queries {
+                if got, ok := q.query(q.arg); ok != q.ok || got != q.expected {
+                    t.Errorf("%s(%d) expected (%d, %v), got (%d, %v)", q.name, q.arg, q.expected, q.ok, got, ok)
+                }
+            }
+
+            if got, _ := tree.Min(); got != 20 {
+                t.Errorf("Min() expected 20, got %d", got)
+            }
+            if got, _ := tree.Max(); got != 80 {
+                t.Errorf("Max() expected 80, got %d", got)
+            }
+            if got := tree.Rank(50); got != 3 {
+                t.Errorf("Rank(50) expected 3, got %d", got)
+            }
+            if got := tree.Rank(45); got != 3 {
+                t.Errorf("Rank(45) expected 3, got %d", got)
+            }
+            expected := []int{40, 50, 70}
+            if got := iteratorValues(tree.Range(35, 70)); !slices.Equal(got, expected) {
+                t.Errorf("Range(35, 70) expected %v, got %v", expected, got)
+            }
+        })
+    }
+}
+
+// TestOrderedQueriesEmpty tests the queries on an empty tree.
+func TestOrderedQueriesEmpty(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for name, query := range map[string]func() (int, bool){
+                "Min":         tree.Min,
+                "Max":         tree.Max,
+                "Floor":       func() (int, bool) { return tree.Floor(1) },
+                "Ceiling":     func() (int, bool) { return tree.Ceiling(1) },
+                "Predecessor": func() (int, bool) { return tree.Predecessor(1) },
+                "Successor":   func() (int, bool) { return tree.Successor(1) },
+                "Select":      func() (int, bool) { return tree.Select(0) },
+            } {
+                if _, ok := query(); ok {
+                    t.Errorf("%s() on empty tree should return false", name)
+                }
+            }
+            if tree.Rank(1) != 0 {
+                t.Error("Rank() on empty tree should be 0")
+            }
+            if tree.Range(0, 10).HasNext() {
+                t.Error("Range() on empty tree should be empty")
+            }
+        })
+    }
+}
+
+// TestOrderedQueriesRange tests range bounds, inverted bounds and exhaustion.
+func TestOrderedQueriesRange(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for v := 0; v < 100; v += 10 {
+                tree.Add(v)
+            }
+
+            ranges := []struct {
+                low, high int
+                expected  []int
+            }{
+                {-50, 1000, []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}},
+                {10, 30, []int{10, 20, 30}},
+                {11, 29, []int{20}},
+                {11, 19, []int{}},
+                {40, 40, []int{40}},
+                {50, 20, []int{}},
+            }
+            for _, r := range ranges {
+                if got := iteratorValues(tree.Range(r.low, r.high)); !slices.Equal(got, r.expected) {
+                    t.Errorf("Range(%d, %d) expected %v, got %v", r.low, r.high, r.expected, got)
+                }
+            }
+
+            it := tree.Range(85, 95)
+            it.Next()
+            defer func() {
+                if recover() == nil {
+                    t.Error("Next() on exhausted range iterator should panic")
+                }
+            }()
+            it.Next()
+        })
+    }
+}
+
+// TestOrderedQueriesRandom compares every query with a sorted slice, after random adds and removes.
+func TestOrderedQueriesRandom(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            rng := rand.New(rand.NewSource(7))
+            tree := impl.new()
+            for range 2000 {
+                if value := rng.Intn(500); rng.Intn(4) == 0 {
+                    tree.Remove(value)
+                } else {
+                    tree.Add(value)
+                }
+            }
+            sorted := slices.Collect(tree.All())
+
+            for k, v := range sorted {
+                if got, ok := tree.Select(k); !ok || got != v {
+                    t.Fatalf("Select(%d) expected %d, got (%d, %v)", k, v, got, ok)
+                }
+            }
+            for probe := -1; probe <= 501; probe++ {
+                // Position of the first value >= probe
+                i, found := slices.BinarySearch(sorted, probe)
+                if got := tree.Rank(probe); got != i {
+                    t.Fatalf("Rank(%d) expected %d, got %d", probe, i, got)
+                }
+
+                assertQuery(t, "Ceiling", probe, sorted, i, tree.Ceiling)
+                assertQuery(t, "Predecessor", probe, sorted, i-1, tree.Predecessor)
+                if found {
+                    assertQuery(t, "Floor", probe, sorted, i, tree.Floor)
+                    assertQuery(t, "Successor", probe, sorted, i+1, tree.Successor)
+                } else {
+                    assertQuery(t, "Floor", probe, sorted, i-1, tree.Floor)
+                    assertQuery(t, "Successor", probe, sorted, i, tree.Successor)
+                }
+
+                high := probe + 37
+                j, _ := slices.BinarySearch(sorted, high+1)
+                if got := iteratorValues(tree.Range(probe, high)); !slices.Equal(got, sorted[i:j]) {
+                    t.Fatalf("Range(%d, %d) expected %v, got %v", probe, high, sorted[i:j], got)
+                }
+            }
+        })
+    }
+}
+
+// assertQuery checks that a query returns sorted[index], or false if index is out of bounds
+func assertQuery(t *testing.T, name string, probe int, sorted []int, index int, query func(int) (int, bool)) {
+    t.Helper()
+    got, ok := query(probe)
+    if index < 0 || index >= len(sorted) {
+        if ok {
+            t.Fatalf("%s(%d) expected no value, got %d", name, probe, got)
+        }
+        return
+    }
+    if !ok || got != sorted[index] {
+        t.Fatalf("%s(%d) expected %d, got (%d, %v)", name, probe, sorted[index], got, ok)
+    }
+}
+
+// iteratorValues drains an iterator into a slice, never nil
+func iteratorValues(it Iterator[int]) []int {
+    values := []int{}
+    for it.HasNext() {
+        values = append(values, it.Next())
+    }
+    return values
+}
@@ -0,0 +1,340 @@
+package tree
+
+import (
+    "cmp"
+    "iter"
+
+    "interview_go/internal/util/iterator"
+
+    "golang.org/x/exp/constraints"
+)
+
+// RedBlackTree is a left-leaning red-black tree (Sedgewick's LLRB), a self-balancing Binary Search
+// Tree. Each node stores the color of the link from its parent (red links are drawn with //):
+//
+//            40
+//          //  \
+//        20     50
+//       /  \
+//     10    30
+//
+// A red link glues a node to its parent, as the two halves of a 3-node of a 2-3 tree. The tree
+// keeps these invariants:
+//
+// - Red links lean left: a right link is never red
+// - No node has two red links connected to it: no 4-node
+// - Every path from the root to a nil link has the same number of black links
+//
+// Together, they bound the height to 2 log2(n), so every operation is O(log n). Compared to
+// AVLTree, lookups are slightly slower (the tree is less strictly balanced) but updates rotate
+// less.
+//
+// The tree compares with a comparator, which lets TreeMap store its entries in it, and TreeSet
+// accept any element type.
+type RedBlackTree[T any] struct {
+    root       *Node[T]
+    size       int
+    comparator func(a, b T) int // Returns: <0 if a<b, 0 if a==b, >0 if a>b
+}
+
+// NewRedBlackTree creates and returns a new empty red-black tree.
+func NewRedBlackTree[T constraints.Ordered]() *RedBlackTree[T] {
+    return newRedBlackTreeFunc(cmp.Compare[T])
+}
+
+// newRedBlackTreeFunc creates an empty red-black tree ordered by comparator
+func newRedBlackTreeFunc[T any](comparator func(a, b T) int) *RedBlackTree[T] {
+    return &RedBlackTree[T]{comparator: comparator}
+}
+
+// Compile-time check to ensure RedBlackTree implements the Tree and OrderedTree interfaces
+var _ OrderedTree[string] = (*RedBlackTree[string])(nil)
+
+// isRed returns true if the link to node is red. Nil links are black.
+func isRed[T any](node *Node[T]) bool {
+    return node != nil && node.red
+}
+
+// redRotateLeft turns a right-leaning red link into a left-leaning one
+//
+//      h                   x
+//     / \\               //  \
+//    a    x      =>      h    c
+//        / \            / \
+//       b   c          a   b
+func redRotateLeft[T any](h *Node[T]) *Node[T] {
+    x := h.right
+    h.right = x.left
+    x.left = h
+    x.red = h.red
+    h.red = true
+    x.size = h.size
+    h.size = 1 + nodeSize(h.left) + nodeSize(h.right)
+    return x
+}
+
+// redRotateRight turns a left-leaning red link into a right-leaning one
+func redRotateRight[T any](h *Node[T]) *Node[T] {
+    x := h.left
+    h.left = x.right
+    x.right = h
+    x.red = h.red
+    h.red = true
+    x.size = h.size
+    h.size = 1 + nodeSize(h.left) + nodeSize(h.right)
+    return x
+}
+
+// flipColors splits a temporary 4-node (both children red) by passing the red link up to the
+// parent, or does the reverse when deleting
+func flipColors[T any](h *Node[T]) {
+    h.red = !h.red
+    h.left.red = !h.left.red
+    h.right.red = !h.right.red
+}
+
+// fixUp restores the invariants on the way back up from an insert or a delete, and the size of h
+// whose subtree changed
+func fixUp[T any](h *Node[T]) *Node[T] {
+    h.size = 1 + nodeSize(h.left) + nodeSize(h.right)
+    if isRed(h.right) && !isRed(h.left) {
+        h = redRotateLeft(h)
+    }
+    if isRed(h.left) && isRed(h.left.left) {
+        h = redRotateRight(h)
+    }
+    if isRed(h.left) && isRed(h.right) {
+        flipColors(h)
+    }
+    return h
+}
+
+// moveRedLeft makes h.left or one of its children red, assuming h is red and both h.left and
+// h.left.left are black, so that the delete can go down the left side
+func moveRedLeft[T any](h *Node[T]) *Node[T] {
+    flipColors(h)
+    if isRed(h.right.left) {
+        h.right = redRotateRight(h.right)
+        h = redRotateLeft(h)
+        flipColors(h)
+    }
+    return h
+}
+
+// moveRedRight makes h.right or one of its children red, assuming h is red and both h.right and
+// h.right.left are black, so that the delete can go down the right side
+func moveRedRight[T any](h *Node[T]) *Node[T] {
+    flipColors(h)
+    if isRed(h.left.left) {
+        h = redRotateRight(h)
+        flipColors(h)
+    }
+    return h
+}
+
+// Root returns the value at the root of the tree.
+// Returns the zero value and false if the tree is empty.
+func (r *RedBlackTree[T]) Root() (T, bool) {
+    if r.root == nil {
+        return *new(T), false
+    }
+    return r.root.value, true
+}
+
+// find returns the node holding a value equal to value, or nil
+func (r *RedBlackTree[T]) find(value T) *Node[T] {
+    node := r.root
+    for node != nil {
+        c := r.comparator(value, node.value)
+        switch {
+        case c < 0:
+            node = node.left
+        case c > 0:
+            node = node.right
+        default:
+            return node
+        }
+    }
+    return nil
+}
+
+// Add inserts a value, rebalancing the tree. Duplicates are ignored.
+//
+// Time complexity: O(log n)
+func (r *RedBlackTree[T]) Add(value T) {
+    r.put(value)
+}
+
+// put inserts value if no equal value exists, and returns the node holding the value that is in
+// the tree, with true if it was added
+func (r *RedBlackTree[T]) put(value T) (*Node[T], bool) {
+    var found *Node[T]
+    var added bool
+    r.root, found, added = r.insert(r.root, value)
+    r.root.red = false
+    if added {
+        r.size++
+    }
+    return found, added
+}
+
+// insert adds value to the subtree as a red leaf, then fixes the invariants on the way up
+func (r *RedBlackTree[T]) insert(h *Node[T], value T) (*Node[T], *Node[T], bool) {
+    if h == nil {
+        leaf := newNode(value)
+        leaf.red = true
+        return leaf, leaf, true
+    }
+
+    var found *Node[T]
+    var added bool
+    c := r.comparator(value, h.value)
+    switch {
+    case c < 0:
+        h.left, found, added = r.insert(h.left, value)
+    case c > 0:
+        h.right, found, added = r.insert(h.right, value)
+    default:
+        return h, h, false
+    }
+    return fixUp(h), found, added
+}
+
+// Search looks for a value in the tree.
+// Returns the value and true if found, otherwise returns zero value and false.
+//
+// Time complexity: O(log n)
+func (r *RedBlackTree[T]) Search(value T) (T, bool) {
+    if node := r.find(value); node != nil {
+        return node.value, true
+    }
+    return *new(T), false
+}
+
+// Remove deletes a value, rebalancing the tree.
+// Returns the removed value and true if found, otherwise returns zero value and false.
+//
+// Time complexity: O(log n)
+func (r *RedBlackTree[T]) Remove(value T) (T, bool) {
+    node := r.find(value)
+    if node == nil {
+        // The delete below reshapes the tree on its way down: only run it for a present value
+        return *new(T), false
+    }
+    removed := node.value
+
+    if !isRed(r.root.left) && !isRed(r.root.right) {
+        r.root.red = true
+    }
+    r.root = r.delete(r.root, value)
+    if r.root != nil {
+        r.root.red = false
+    }
+    r.size--
+    return removed, true
+}
+
+// delete removes value, which must be in the subtree. On the way down it keeps the current node
+// red or with a red child, so that the removed node is never a lone black node.
+func (r *RedBlackTree[T]) delete(h *Node[T], value T) *Node[T] {
+    if r.comparator(value, h.value) < 0 {
+        if !isRed(h.left) && !isRed(h.left.left) {
+            h = moveRedLeft(h)
+        }
+        h.left = r.delete(h.left, value)
+        return fixUp(h)
+    }
+
+    if isRed(h.left) {
+        h = redRotateRight(h)
+    }
+    if r.comparator(value, h.value) == 0 && h.right == nil {
+        return nil
+    }
+    if !isRed(h.right) && !isRed(h.right.left) {
+        h = moveRedRight(h)
+    }
+    if r.comparator(value, h.value) == 0 {
+        // Replace by the in-order successor, then remove the successor
+        successor := h.right
+        for successor.left != nil {
+            successor = successor.left
+        }
+        h.value = successor.value
+        h.right = deleteMin(h.right)
+    } else {
+        h.right = r.delete(h.right, value)
+    }
+    return fixUp(h)
+}
+
+// deleteMin removes the smallest node of the subtree
+func deleteMin[T any](h *Node[T]) *Node[T] {
+    if h.left == nil {
+        return nil
+    }
+    if !isRed(h.left) && !isRed(h.left.left) {
+        h = moveRedLeft(h)
+    }
+    h.left = deleteMin(h.left)
+    return fixUp(h)
+}
+
+// Clear removes all elements from the tree, leaving it empty.
+func (r *RedBlackTree[T]) Clear() {
+    r.root = nil
+    r.size = 0
+}
+
+// Size returns the number of elements in the tree.
+func (r *RedBlackTree[T]) Size() int {
+    return r.size
+}
+
+// The ordered queries are documented on OrderedTree.
+
+func (r *RedBlackTree[T]) Min() (T, bool) {
+    return minNode(r.root)
+}
+
+func (r *RedBlackTree[T]) Max() (T, bool) {
+    return maxNode(r.root)
+}
+
+func (r *RedBlackTree[T]) Floor(value T) (T, bool) {
+    return below(r.root, value, true, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Ceiling(value T) (T, bool) {
+    return above(r.root, value, true, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Predecessor(value T) (T, bool) {
+    return below(r.root, value, false, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Successor(value T) (T, bool) {
+    return above(r.root, value, false, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Range(low, high T) Iterator[T] {
+    return newRangeIterator(r.root, low, high, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Rank(value T) int {
+    return rank(r.root, value, r.comparator)
+}
+
+func (r *RedBlackTree[T]) Select(k int) (T, bool) {
+    return selectKth(r.root, k)
+}
+
+// Iterator returns an in-order iterator, from the smallest to the largest value.
+func (r *RedBlackTree[T]) Iterator() Iterator[T] {
+    return newInOrderIterator(r.root)
+}
+
+// All returns the in-order traversal as a sequence, for use with range.
+func (r *RedBlackTree[T]) All() iter.Seq[T] {
+    return iterator.ToSeq(r.Iterator())
+}
@@ -0,0 +1,115 @@
+package tree
+
+import (
+    "math"
+    "math/rand"
+    "slices"
+    "testing"
+    "testing/quick"
+)
+
+// checkRedBlack verifies the BST order and the left-leaning red-black invariants of a subtree whose
+// values must be in (low, high), and returns its black height and size
+func checkRedBlack(t *testing.T, node *Node[int], low, high int) (int, int) {
+    t.Helper()
+    if node == nil {
+        return 1, 0
+    }
+    if node.value <= low || node.value >= high {
+        t.Fatalf("Node %d is outside (%d, %d): BST order broken", node.value, low, high)
+    }
+    if isRed(node.right) {
+        t.Fatalf("Node %d has a red right link", node.value)
+    }
+    if isRed(node) && isRed(node.left) {
+        t.Fatalf("Node %d and its left child are both red", node.value)
+    }
+
+    leftBlack, leftSize := checkRedBlack(t, node.left, low, node.value)
+    rightBlack, rightSize := checkRedBlack(t, node.right, node.value, high)
+    if leftBlack != rightBlack {
+        t.Fatalf("Node %d has black heights %d and %d", node.value, leftBlack, rightBlack)
+    }
+    if !node.red {
+        leftBlack++
+    }
+    return leftBlack, 1 + leftSize + rightSize
+}
+
+// assertRedBlack checks the invariants of the whole tree and its size
+func assertRedBlack(t *testing.T, tree *RedBlackTree[int]) {
+    t.Helper()
+    if isRed(tree.root) {
+        t.Fatal("The root is red")
+    }
+    if _, size := checkRedBlack(t, tree.root, math.MinInt, math.MaxInt); size != tree.Size() {
+        t.Fatalf("Size() is %d, but the tree holds %d nodes", tree.Size(), size)
+    }
+}
+
+// depth returns the number of nodes on the longest path from node to a leaf
+func depth[T any](node *Node[T]) int {
+    if node == nil {
+        return 0
+    }
+    return 1 + max(depth(node.left), depth(node.right))
+}
+
+// TestRedBlackTreeSortedInserts tests that sorted inserts keep the height logarithmic.
+func TestRedBlackTreeSortedInserts(t *testing.T) {
+    const count = 1 << 12
+    tree := NewRedBlackTree[int]()
+    for i := 0; i < count; i++ {
+        tree.Add(i)
+    }
+    assertRedBlack(t, tree)
+
+    if h, bound := depth(tree.root), int(2*math.Log2(count)); h > bound {
+        t.Errorf("Height %d exceeds the red-black bound %d", h, bound)
+    }
+    if got := slices.Collect(tree.All()); !slices.IsSorted(got) || len(got) != count {
+        t.Errorf("In-order traversal should return %d sorted values", count)
+    }
+}
+
+// TestRedBlackTreeStrings tests the tree with another ordered type.
+func TestRedBlackTreeStrings(t *testing.T) {
+    tree := NewRedBlackTree[string]()
+    for _, v := range []string{"dog", "cat", "elephant", "ant", "bird"} {
+        tree.Add(v)
+    }
+    tree.Remove("cat")
+
+    expected := []string{"ant", "bird", "dog", "elephant"}
+    if got := slices.Collect(tree.All()); !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+}
+
+// TestRedBlackTreeProperties is a property-based test: any sequence of adds and removes keeps the
+// red-black invariants.
+func TestRedBlackTreeProperties(t *testing.T) {
+    property := func(ops []int8) bool {
+        tree := NewRedBlackTree[int]()
+        reference := map[int]bool{}
+        for _, op := range ops {
+            value := int(op) / 4
+            if op%2 == 0 {
+                tree.Add(value)
+                reference[value] = true
+            } else {
+                if _, removed := tree.Remove(value); removed != reference[value] {
+                    return false
+                }
+                delete(reference, value)
+            }
+            assertRedBlack(t, tree)
+        }
+        return tree.Size() == len(reference)
+    }
+
+    config := &quick.Config{MaxCount: 500, Rand: rand.New(rand.NewSource(42))}
+    if err := quick.Check(property, config); err != nil {
+        t.Error(err)
+    }
+}
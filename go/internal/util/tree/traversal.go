@@ -0,0 +1,255 @@
+package tree
+
+import (
+    "interview_go/internal/util/queue"
+    "interview_go/internal/util/stack"
+)
+
+// Traversal orders other than in-order, for this tree:
+//
+//            10
+//         /     \
+//        5       15
+//       / \     /  \
+//      3   7   12  20
+//
+//   PreOrder (root, left, right):        10, 5, 3, 7, 15, 12, 20   // Copying, serializing
+//   PostOrder (left, right, root):       3, 7, 5, 12, 20, 15, 10   // Freeing, evaluating
+//   LevelOrder (breadth first):          10, 5, 15, 3, 7, 12, 20   // Level by level
+//   ReverseInOrder (right, root, left):  20, 15, 12, 10, 7, 5, 3   // Largest first
+//
+// Re-adding the pre-order or level-order sequence to an empty BinaryTree rebuilds the same shape.
+
+// preOrderIterator visits each node before its subtrees. The stack holds the subtrees still to
+// visit: the right one is pushed first so that the left one comes out first.
+type preOrderIterator[T any] struct {
+    stack stack.Stack[*Node[T]]
+}
+
+// newPreOrderIterator creates a new pre-order iterator starting from the given root node.
+func newPreOrderIterator[T any](root *Node[T]) Iterator[T] {
+    it := &preOrderIterator[T]{stack: stack.NewArrayStack[*Node[T]](0)}
+    if root != nil {
+        it.stack.Push(root)
+    }
+    return it
+}
+
+// Compile-time check to ensure preOrderIterator implements the Iterator interface
+var _ Iterator[int] = (*preOrderIterator[int])(nil)
+
+func (it *preOrderIterator[T]) HasNext() bool {
+    return !it.stack.IsEmpty()
+}
+
+func (it *preOrderIterator[T]) Next() T {
+    if it.stack.IsEmpty() {
+        panic(errExhausted)
+    }
+    node := it.stack.Pop()
+    if node.right != nil {
+        it.stack.Push(node.right)
+    }
+    if node.left != nil {
+        it.stack.Push(node.left)
+    }
+    return node.value
+}
+
+// postOrderIterator visits each node after its subtrees. The stack holds the path from the root
+// to the next node, which is always on top.
+//
+// Example, with the tree above:
+//
+//   init: descend to the first leaf, preferring left:  [10, 5, 3]
+//   Next() -> 3, 5 is on top and 3 was its left child: descend into 7  -> [10, 5, 7]
+//   Next() -> 7, it was the right child of 5: 5 is next                -> [10, 5]
+//   Next() -> 5, it was the left child of 10: descend into 15, 12     -> [10, 15, 12]
+//   ...
+type postOrderIterator[T any] struct {
+    stack stack.Stack[*Node[T]]
+}
+
+// newPostOrderIterator creates a new post-order iterator starting from the given root node.
+func newPostOrderIterator[T any](root *Node[T]) Iterator[T] {
+    it := &postOrderIterator[T]{stack: stack.NewArrayStack[*Node[T]](0)}
+    it.descend(root)
+    return it
+}
+
+// Compile-time check to ensure postOrderIterator implements the Iterator interface
+var _ Iterator[int] = (*postOrderIterator[int])(nil)
+
+// descend pushes the path to the first node in post-order of the subtree: its leftmost leaf,
+// going right only when there is no left child
+func (it *postOrderIterator[T]) descend(node *Node[T]) {
+    for node != nil {
+        it.stack.Push(node)
+        if node.left != nil {
+            node = node.left
+        } else {
+            node = node.right
+        }
+    }
+}
+
+func (it *postOrderIterator[T]) HasNext() bool {
+    return !it.stack.IsEmpty()
+}
+
+func (it *postOrderIterator[T]) Next() T {
+    if it.stack.IsEmpty() {
+        panic(errExhausted)
+    }
+    node := it.stack.Pop()
+    // Coming back up from a left child: the right subtree of the parent comes before the parent
+    if parent, ok := it.stack.Peek(); ok && parent.left == node {
+        it.descend(parent.right)
+    }
+    return node.value
+}
+
+// levelOrderIterator visits the nodes level by level, from left to right (breadth-first search).
+// The queue holds the nodes of the current level not visited yet, followed by the children of the
+// visited ones.
+type levelOrderIterator[T any] struct {
+    queue queue.Queue[*Node[T]]
+}
+
+// newLevelOrderIterator creates a new level-order iterator starting from the given root node.
+func newLevelOrderIterator[T any](root *Node[T]) Iterator[T] {
+    it := &levelOrderIterator[T]{queue: queue.NewRingBufferQueue[*Node[T]](0)}
+    if root != nil {
+        it.queue.Offer(root)
+    }
+    return it
+}
+
+// Compile-time check to ensure levelOrderIterator implements the Iterator interface
+var _ Iterator[int] = (*levelOrderIterator[int])(nil)
+
+func (it *levelOrderIterator[T]) HasNext() bool {
+    return !it.queue.IsEmpty()
+}
+
+func (it *levelOrderIterator[T]) Next() T {
+    node, ok := it.queue.Poll()
+    if !ok {
+        panic(errExhausted)
+    }
+    if node.left != nil {
+        it.queue.Offer(node.left)
+    }
+    if node.right != nil {
+        it.queue.Offer(node.right)
+    }
+    return node.value
+}
+
+// reverseInOrderIterator is the mirror of inOrderIterator: it pushes the rightmost path, so the
+// values come out from the largest to the smallest.
+type reverseInOrderIterator[T any] struct {
+    stack stack.Stack[*Node[T]]
+}
+
+// newReverseInOrderIterator creates a new reverse in-order iterator starting from the given root node.
+func newReverseInOrderIterator[T any](root *Node[T]) Iterator[T] {
+    it := &reverseInOrderIterator[T]{stack: stack.NewArrayStack[*Node[T]](0)}
+    it.pushRight(root)
+    return it
+}
+
+// Compile-time check to ensure reverseInOrderIterator implements the Iterator interface
+var _ Iterator[int] = (*reverseInOrderIterator[int])(nil)
+
+// pushRight pushes all nodes on the right side of the given node to the stack
+func (it *reverseInOrderIterator[T]) pushRight(node *Node[T]) {
+    for node != nil {
+        it.stack.Push(node)
+        node = node.right
+    }
+}
+
+func (it *reverseInOrderIterator[T]) HasNext() bool {
+    return !it.stack.IsEmpty()
+}
+
+func (it *reverseInOrderIterator[T]) Next() T {
+    if it.stack.IsEmpty() {
+        panic(errExhausted)
+    }
+    node := it.stack.Pop()
+    it.pushRight(node.left)
+    return node.value
+}
+
+// MorrisIterator is an in-order iterator using O(1) extra space: instead of a stack, it finds its
+// way back up through temporary links ("threads") from the in-order predecessor of a node to the
+// node itself.
+//
+// Example, on the tree above:
+//
+//   At 10: the predecessor 7 has no right child: thread 7 -> 10, go left to 5
+//   At 5:  thread 3 -> 5, go left to 3
+//   At 3:  no left child: return 3, follow the thread back to 5
+//   At 5:  its predecessor already threads to 5: remove the thread, return 5, go right to 7
+//   ...
+//
+// Every link is followed at most three times, so a full traversal is O(n).
+//
+// While the iteration is in progress the tree is temporarily modified: it must not be used by
+// anything else, not even read, and an iterator abandoned before its end must be stopped with Stop
+// to restore the tree.
+type MorrisIterator[T any] struct {
+    current *Node[T]
+}
+
+// newMorrisIterator creates a new Morris iterator starting from the given root node.
+func newMorrisIterator[T any](root *Node[T]) *MorrisIterator[T] {
+    return &MorrisIterator[T]{current: root}
+}
+
+// Compile-time check to ensure MorrisIterator implements the Iterator interface
+var _ Iterator[int] = (*MorrisIterator[int])(nil)
+
+// HasNext returns true if there are more elements to traverse: the current node is always the
+// next one returned, or an ancestor of it.
+func (it *MorrisIterator[T]) HasNext() bool {
+    return it.current != nil
+}
+
+// Next returns the next element in the in-order traversal.
+// Panics with an error wrapping errs.ErrExhausted if called when there are no remaining elements.
+func (it *MorrisIterator[T]) Next() T {
+    for it.current != nil {
+        node := it.current
+        if node.left == nil {
+            it.current = node.right
+            return node.value
+        }
+
+        predecessor := node.left
+        for predecessor.right != nil && predecessor.right != node {
+            predecessor = predecessor.right
+        }
+        if predecessor.right == nil {
+            // First visit: thread the way back, and go down the left subtree
+            predecessor.right = node
+            it.current = node.left
+        } else {
+            // Back from the left subtree: remove the thread, and visit the node
+            predecessor.right = nil
+            it.current = node.right
+            return node.value
+        }
+    }
+    panic(errExhausted)
+}
+
+// Stop ends the iteration early, removing the remaining threads so that the tree is back to its
+// original shape. It finishes the traversal without returning values, in O(n).
+func (it *MorrisIterator[T]) Stop() {
+    for it.HasNext() {
+        it.Next()
+    }
+}
@@ -0,0 +1,211 @@
+package tree
+
+import (
+    "errors"
+    "math/rand"
+    "slices"
+    "testing"
+
+    "interview_go/internal/util/errs"
+)
+
+// newTraversalTree builds the tree of the traversal examples:
+//
+//            10
+//         /     \
+//        5       15
+//       / \     /  \
+//      3   7   12  20
+func newTraversalTree() *BinaryTree[int] {
+    tree := NewBinaryTree[int]()
+    for _, v := range []int{10, 5, 15, 3, 7, 12, 20} {
+        tree.Add(v)
+    }
+    return tree
+}
+
+// traversals lists every iterator of BinaryTree, for the shared tests
+var traversals = []struct {
+    name     string
+    iterator func(tree *BinaryTree[int]) Iterator[int]
+}{
+    {"PreOrder", (*BinaryTree[int]).PreOrderIterator},
+    {"PostOrder", (*BinaryTree[int]).PostOrderIterator},
+    {"LevelOrder", (*BinaryTree[int]).LevelOrderIterator},
+    {"ReverseInOrder", (*BinaryTree[int]).ReverseInOrderIterator},
+    {"Morris", func(tree *BinaryTree[int]) Iterator[int] { return tree.MorrisIterator() }},
+}
+
+// TestTraversalOrders tests every traversal on the example tree.
+func TestTraversalOrders(t *testing.T) {
+    tree := newTraversalTree()
+    expected := map[string][]int{
+        "PreOrder":       {10, 5, 3, 7, 15, 12, 20},
+        "PostOrder":      {3, 7, 5, 12, 20, 15, 10},
+        "LevelOrder":     {10, 5, 15, 3, 7, 12, 20},
+        "ReverseInOrder": {20, 15, 12, 10, 7, 5, 3},
+        "Morris":         {3, 5, 7, 10, 12, 15, 20},
+    }
+    for _, traversal := range traversals {
+        t.Run(traversal.name, func(t *testing.T) {
+            if got := iteratorValues(traversal.iterator(tree)); !slices.Equal(got, expected[traversal.name]) {
+                t.Errorf("Expected %v, got %v", expected[traversal.name], got)
+            }
+        })
+    }
+}
+
+// TestTraversalEmptyAndSingle tests the edge cases shared by every traversal.
+func TestTraversalEmptyAndSingle(t *testing.T) {
+    for _, traversal := range traversals {
+        t.Run(traversal.name, func(t *testing.T) {
+            empty := traversal.iterator(NewBinaryTree[int]())
+            if empty.HasNext() {
+                t.Error("HasNext() on empty tree should be false")
+            }
+
+            single := NewBinaryTree[int]()
+            single.Add(42)
+            if got := iteratorValues(traversal.iterator(single)); !slices.Equal(got, []int{42}) {
+                t.Errorf("Expected [42], got %v", got)
+            }
+        })
+    }
+}
+
+// TestTraversalSkewedTrees tests the traversals on trees that are linked lists.
+func TestTraversalSkewedTrees(t *testing.T) {
+    left, right := NewBinaryTree[int](), NewBinaryTree[int]()
+    for i := 1; i <= 4; i++ {
+        left.Add(5 - i)
+        right.Add(i)
+    }
+
+    expected := map[string][2][]int{
+        "PreOrder":       {{4, 3, 2, 1}, {1, 2, 3, 4}},
+        "PostOrder":      {{1, 2, 3, 4}, {4, 3, 2, 1}},
+        "LevelOrder":     {{4, 3, 2, 1}, {1, 2, 3, 4}},
+        "ReverseInOrder": {{4, 3, 2, 1}, {4, 3, 2, 1}},
+        "Morris":         {{1, 2, 3, 4}, {1, 2, 3, 4}},
+    }
+    for _, traversal := range traversals {
+        t.Run(traversal.name, func(t *testing.T) {
+            for i, tree := range []*BinaryTree[int]{left, right} {
+                if got := iteratorValues(traversal.iterator(tree)); !slices.Equal(got, expected[traversal.name][i]) {
+                    t.Errorf("Expected %v, got %v", expected[traversal.name][i], got)
+                }
+            }
+        })
+    }
+}
+
+// TestTraversalPanicWhenExhausted tests that every traversal panics with ErrExhausted at the end.
+func TestTraversalPanicWhenExhausted(t *testing.T) {
+    for _, traversal := range traversals {
+        t.Run(traversal.name, func(t *testing.T) {
+            it := traversal.iterator(newTraversalTree())
+            iteratorValues(it)
+
+            defer func() {
+                err, ok := recover().(error)
+                if !ok || !errors.Is(err, errs.ErrExhausted) {
+                    t.Errorf("Expected a panic wrapping ErrExhausted, got %v", err)
+                }
+            }()
+            it.Next()
+        })
+    }
+}
+
+// TestTraversalRandomTrees compares every traversal with a recursive reference on random trees.
+func TestTraversalRandomTrees(t *testing.T) {
+    rng := rand.New(rand.NewSource(42))
+    for range 50 {
+        tree := NewBinaryTree[int]()
+        for range rng.Intn(200) {
+            tree.Add(rng.Intn(1000))
+        }
+
+        // Recursive references: pre-order within a depth is left to right, as in level order
+        var pre, post, in []int
+        var byDepth [][]int
+        var walk func(node *Node[int], depth int)
+        walk = func(node *Node[int], depth int) {
+            if node == nil {
+                return
+            }
+            if depth == len(byDepth) {
+                byDepth = append(byDepth, nil)
+            }
+            byDepth[depth] = append(byDepth[depth], node.value)
+            pre = append(pre, node.value)
+            walk(node.left, depth+1)
+            in = append(in, node.value)
+            walk(node.right, depth+1)
+            post = append(post, node.value)
+        }
+        walk(tree.root, 0)
+        reversed := slices.Clone(in)
+        slices.Reverse(reversed)
+
+        expected := map[string][]int{
+            "PreOrder":       pre,
+            "PostOrder":      post,
+            "LevelOrder":     slices.Concat(byDepth...),
+            "ReverseInOrder": reversed,
+            "Morris":         in,
+        }
+        for _, traversal := range traversals {
+            if got := iteratorValues(traversal.iterator(tree)); !slices.Equal(got, expected[traversal.name]) {
+                t.Fatalf("%s expected %v, got %v", traversal.name, expected[traversal.name], got)
+            }
+        }
+    }
+}
+
+// TestPreOrderRebuildsSameTree tests that re-adding the pre-order and level-order sequences
+// rebuilds the same shape.
+func TestPreOrderRebuildsSameTree(t *testing.T) {
+    rng := rand.New(rand.NewSource(7))
+    original := NewBinaryTree[int]()
+    for range 300 {
+        original.Add(rng.Intn(10000))
+    }
+    pre := iteratorValues(original.PreOrderIterator())
+
+    for _, order := range [][]int{pre, iteratorValues(original.LevelOrderIterator())} {
+        copied := NewBinaryTree[int]()
+        for _, v := range order {
+            copied.Add(v)
+        }
+        if got := iteratorValues(copied.PreOrderIterator()); !slices.Equal(got, pre) {
+            t.Fatal("The copied tree has a different shape")
+        }
+    }
+}
+
+// TestMorrisIteratorRestoresTree tests that the threads are removed, after a full traversal and
+// after Stop.
+func TestMorrisIteratorRestoresTree(t *testing.T) {
+    tree := newTraversalTree()
+    shape := iteratorValues(tree.PreOrderIterator())
+
+    iteratorValues(tree.MorrisIterator())
+    if got := iteratorValues(tree.PreOrderIterator()); !slices.Equal(got, shape) {
+        t.Errorf("Tree changed after a full Morris traversal: %v", got)
+    }
+
+    it := tree.MorrisIterator()
+    it.Next()
+    it.Next() // Threads are in place now
+    it.Stop()
+    if it.HasNext() {
+        t.Error("HasNext() should be false after Stop")
+    }
+    if got := iteratorValues(tree.PreOrderIterator()); !slices.Equal(got, shape) {
+        t.Errorf("Tree changed after a stopped Morris traversal: %v", got)
+    }
+    if got := checkSizes(t, tree.root); got != tree.Size() {
+        t.Errorf("Expected %d nodes, found %d", tree.Size(), got)
+    }
+}
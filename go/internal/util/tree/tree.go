@@ -1,5 +1,13 @@
 package tree
 
+import (
+    "fmt"
+    "iter"
+
+    "interview_go/internal/util/errs"
+    "interview_go/internal/util/iterator"
+)
+
 // Tree is a generic interface representing the structure and operations of a tree.
 //
 // Example Tree Structure:
@@ -82,14 +90,18 @@ type Tree[T any] interface {
     // Space complexity: O(h) where h is the height (for the traversal stack)
     // Traversing all elements: O(n) where n is the number of nodes
     Iterator() Iterator[T]
+
+    // All returns a sequence over the elements in the same order as Iterator, for use with range.
+    All() iter.Seq[T]
 }
 
-// Iterator is a generic interface for traversing elements of the tree.
-type Iterator[T any] interface {
-    // HasNext returns true if there are more elements to traverse.
-    HasNext() bool
+// Iterator is the interface for traversing elements of the tree.
+// HasNext returns true if there are more elements to traverse, and Next returns the next
+// sequential element, panicking when there are no remaining elements.
+//
+// It is an alias of iterator.Iterator, so tree iterators can be passed straight to the
+// combinators in the iterator package (Map, Filter, Take, ...).
+type Iterator[T any] = iterator.Iterator[T]
 
-    // Next returns the next sequential element in the tree.
-    // If called when there are no remaining elements, it panics.
-    Next() T
-}
+// errExhausted is the panic value for calling Next() on a finished tree iterator
+var errExhausted = fmt.Errorf("tree: %w", errs.ErrExhausted)
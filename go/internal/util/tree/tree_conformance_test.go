@@ -0,0 +1,224 @@
+package tree
+
+import (
+    "math/rand"
+    "slices"
+    "testing"
+)
+
+// treeImplementations lists every Tree, so that they all run the same conformance tests.
+// validate checks the implementation's own invariants, on top of the subtree sizes checked by
+// assertContent.
+var treeImplementations = []struct {
+    name     string
+    new      func() OrderedTree[int]
+    validate func(t *testing.T, tree OrderedTree[int])
+}{
+    {"BinaryTree", func() OrderedTree[int] { return NewBinaryTree[int]() }, func(*testing.T, OrderedTree[int]) {}},
+    {"AVLTree", func() OrderedTree[int] { return NewAVLTree[int]() }, func(t *testing.T, tree OrderedTree[int]) {
+        assertAVL(t, tree.(*AVLTree[int]))
+    }},
+    {"RedBlackTree", func() OrderedTree[int] { return NewRedBlackTree[int]() }, func(t *testing.T, tree OrderedTree[int]) {
+        assertRedBlack(t, tree.(*RedBlackTree[int]))
+    }},
+}
+
+// rootOf returns the root node of any implementation
+func rootOf(tree OrderedTree[int]) *Node[int] {
+    switch tree := tree.(type) {
+    case *BinaryTree[int]:
+        return tree.root
+    case *AVLTree[int]:
+        return tree.root
+    case *RedBlackTree[int]:
+        return tree.root
+    }
+    panic("unknown tree implementation")
+}
+
+// checkSizes verifies the subtree size stored in every node, and returns the size of the subtree
+func checkSizes(t *testing.T, node *Node[int]) int {
+    t.Helper()
+    if node == nil {
+        return 0
+    }
+    size := 1 + checkSizes(t, node.left) + checkSizes(t, node.right)
+    if node.size != size {
+        t.Fatalf("Node %d stores size %d, actual %d", node.value, node.size, size)
+    }
+    return size
+}
+
+// assertContent checks that the tree holds exactly the expected values, in order, and that the
+// subtree sizes are right
+func assertContent(t *testing.T, tree OrderedTree[int], expected []int) {
+    t.Helper()
+    checkSizes(t, rootOf(tree))
+    if got := slices.Collect(tree.All()); !slices.Equal(got, expected) {
+        t.Fatalf("Expected %v, got %v", expected, got)
+    }
+    if tree.Size() != len(expected) {
+        t.Fatalf("Expected size %d, got %d", len(expected), tree.Size())
+    }
+}
+
+// TestConformanceEmpty tests every operation on an empty tree.
+func TestConformanceEmpty(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            if _, ok := tree.Root(); ok {
+                t.Error("Root() on empty tree should return false")
+            }
+            if _, ok := tree.Search(1); ok {
+                t.Error("Search() on empty tree should return false")
+            }
+            if _, ok := tree.Remove(1); ok {
+                t.Error("Remove() on empty tree should return false")
+            }
+            assertContent(t, tree, []int{})
+        })
+    }
+}
+
+// TestConformanceAddSearch tests adding values in an order that skews an unbalanced tree, and
+// searching present and missing values.
+func TestConformanceAddSearch(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for _, v := range []int{10, 20, 30, 40, 50, 5, 15, 25} {
+                tree.Add(v)
+                impl.validate(t, tree)
+            }
+            assertContent(t, tree, []int{5, 10, 15, 20, 25, 30, 40, 50})
+
+            if _, ok := tree.Root(); !ok {
+                t.Error("Root() on non-empty tree should return true")
+            }
+            for _, v := range []int{5, 25, 50} {
+                if got, ok := tree.Search(v); !ok || got != v {
+                    t.Errorf("Search(%d) expected (%d, true), got (%d, %v)", v, v, got, ok)
+                }
+            }
+            for _, v := range []int{0, 12, 100} {
+                if _, ok := tree.Search(v); ok {
+                    t.Errorf("Search(%d) should not find a value", v)
+                }
+            }
+        })
+    }
+}
+
+// TestConformanceDuplicates tests that adding an existing value changes nothing, size included.
+func TestConformanceDuplicates(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for _, v := range []int{2, 1, 3, 2, 1, 3, 2} {
+                tree.Add(v)
+            }
+            impl.validate(t, tree)
+            assertContent(t, tree, []int{1, 2, 3})
+        })
+    }
+}
+
+// TestConformanceRemove tests removing leaves, nodes with one child, nodes with two children and
+// the root, until the tree is empty.
+func TestConformanceRemove(t *testing.T) {
+    //          50
+    //        /    \
+    //      30      70
+    //     /  \       \
+    //    20   40      80
+    //   /            /
+    //  10           75
+    values := []int{50, 30, 70, 20, 40, 80, 10, 75}
+    removals := []struct {
+        value     int
+        remaining []int
+    }{
+        {40, []int{10, 20, 30, 50, 70, 75, 80}}, // Leaf
+        {20, []int{10, 30, 50, 70, 75, 80}},     // Left child only
+        {70, []int{10, 30, 50, 75, 80}},         // Right child only
+        {50, []int{10, 30, 75, 80}},             // Root
+        {99, []int{10, 30, 75, 80}},             // Missing
+        {30, []int{10, 75, 80}},
+        {10, []int{75, 80}},
+        {80, []int{75}},
+        {75, []int{}},
+    }
+
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for _, v := range values {
+                tree.Add(v)
+            }
+            for _, removal := range removals {
+                got, ok := tree.Remove(removal.value)
+                if expected := removal.value != 99; ok != expected || (ok && got != removal.value) {
+                    t.Fatalf("Remove(%d) returned (%d, %v)", removal.value, got, ok)
+                }
+                impl.validate(t, tree)
+                assertContent(t, tree, removal.remaining)
+            }
+            if _, ok := tree.Root(); ok {
+                t.Error("Root() should return false once every value is removed")
+            }
+        })
+    }
+}
+
+// TestConformanceClear tests that a cleared tree is empty and reusable.
+func TestConformanceClear(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            tree := impl.new()
+            for v := range 10 {
+                tree.Add(v)
+            }
+            tree.Clear()
+            assertContent(t, tree, []int{})
+
+            tree.Add(3)
+            assertContent(t, tree, []int{3})
+        })
+    }
+}
+
+// TestConformanceRandomOperations compares random adds and removes with a reference set,
+// validating the invariants after every operation.
+func TestConformanceRandomOperations(t *testing.T) {
+    for _, impl := range treeImplementations {
+        t.Run(impl.name, func(t *testing.T) {
+            rng := rand.New(rand.NewSource(42))
+            tree := impl.new()
+            reference := map[int]bool{}
+
+            for range 3000 {
+                value := rng.Intn(200)
+                if rng.Intn(3) == 0 {
+                    _, removed := tree.Remove(value)
+                    if removed != reference[value] {
+                        t.Fatalf("Remove(%d) returned %v, expected %v", value, removed, reference[value])
+                    }
+                    delete(reference, value)
+                } else {
+                    tree.Add(value)
+                    reference[value] = true
+                }
+                impl.validate(t, tree)
+                checkSizes(t, rootOf(tree))
+            }
+
+            expected := make([]int, 0, len(reference))
+            for v := range reference {
+                expected = append(expected, v)
+            }
+            slices.Sort(expected)
+            assertContent(t, tree, expected)
+        })
+    }
+}
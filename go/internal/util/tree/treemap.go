@@ -0,0 +1,138 @@
+package tree
+
+import (
+    "iter"
+
+    "interview_go/internal/util/iterator"
+)
+
+// Entry is a key-value pair of a TreeMap.
+type Entry[K, V any] struct {
+    Key   K
+    Value V
+}
+
+// TreeMap is an ordered map, stored in a RedBlackTree of entries compared by key.
+// Unlike a Go map, keys can be of any type, including structs and slices, as long as the
+// comparator orders them, and iterating returns the entries in key order. Every operation is
+// O(log n).
+//
+//   m := NewTreeMap[string, int](strings.Compare)
+//   m.Put("b", 2)
+//   m.Put("a", 1)
+//   for k, v := range m.All() {
+//       fmt.Println(k, v) // a 1, then b 2
+//   }
+//
+// Keys the comparator considers equal are the same key: Put on such a key replaces the value and
+// keeps the key first stored.
+type TreeMap[K, V any] struct {
+    tree *RedBlackTree[Entry[K, V]]
+}
+
+// NewTreeMap creates and returns a new empty TreeMap ordering its keys with comparator,
+// e.g. cmp.Compare[int] for ordered keys.
+func NewTreeMap[K, V any](comparator func(a, b K) int) *TreeMap[K, V] {
+    return &TreeMap[K, V]{
+        tree: newRedBlackTreeFunc(func(a, b Entry[K, V]) int {
+            return comparator(a.Key, b.Key)
+        }),
+    }
+}
+
+// Put associates value with key. Returns the previous value and true if key was already present,
+// otherwise the zero value and false.
+//
+// Time complexity: O(log n)
+func (m *TreeMap[K, V]) Put(key K, value V) (V, bool) {
+    node, added := m.tree.put(Entry[K, V]{Key: key, Value: value})
+    if added {
+        return *new(V), false
+    }
+    previous := node.value.Value
+    node.value.Value = value
+    return previous, true
+}
+
+// Get returns the value associated with key, and false if key is not present.
+//
+// Time complexity: O(log n)
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+    if node := m.tree.find(Entry[K, V]{Key: key}); node != nil {
+        return node.value.Value, true
+    }
+    return *new(V), false
+}
+
+// ContainsKey returns true if key is present.
+//
+// Time complexity: O(log n)
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+    return m.tree.find(Entry[K, V]{Key: key}) != nil
+}
+
+// Delete removes key. Returns its value and true if it was present.
+//
+// Time complexity: O(log n)
+func (m *TreeMap[K, V]) Delete(key K) (V, bool) {
+    removed, ok := m.tree.Remove(Entry[K, V]{Key: key})
+    return removed.Value, ok
+}
+
+// Size returns the number of keys.
+func (m *TreeMap[K, V]) Size() int {
+    return m.tree.Size()
+}
+
+// IsEmpty returns true if the map has no keys.
+func (m *TreeMap[K, V]) IsEmpty() bool {
+    return m.tree.Size() == 0
+}
+
+// Clear removes all the keys.
+func (m *TreeMap[K, V]) Clear() {
+    m.tree.Clear()
+}
+
+// Iterator returns an iterator over the entries in ascending key order.
+func (m *TreeMap[K, V]) Iterator() Iterator[Entry[K, V]] {
+    return m.tree.Iterator()
+}
+
+// Entries returns a sequence over the entries in ascending key order.
+func (m *TreeMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+    return iterator.ToSeq(m.Iterator())
+}
+
+// All returns a sequence over the key-value pairs in ascending key order, for use with range.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        for e := range m.Entries() {
+            if !yield(e.Key, e.Value) {
+                return
+            }
+        }
+    }
+}
+
+// Keys returns a sequence over the keys in ascending order.
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+    return func(yield func(K) bool) {
+        for e := range m.Entries() {
+            if !yield(e.Key) {
+                return
+            }
+        }
+    }
+}
+
+// Values returns a sequence over the values in ascending key order.
+func (m *TreeMap[K, V]) Values() iter.Seq[V] {
+    return func(yield func(V) bool) {
+        for e := range m.Entries() {
+            if !yield(e.Value) {
+                return
+            }
+        }
+    }
+}
@@ -0,0 +1,168 @@
+package tree
+
+import (
+    "cmp"
+    "math/rand"
+    "slices"
+    "strings"
+    "testing"
+)
+
+// TestTreeMapPutGet tests inserting, replacing and reading values.
+func TestTreeMapPutGet(t *testing.T) {
+    m := NewTreeMap[string, int](strings.Compare)
+    if !m.IsEmpty() {
+        t.Error("New map should be empty")
+    }
+
+    if _, replaced := m.Put("b", 2); replaced {
+        t.Error("Put() of a new key should return false")
+    }
+    m.Put("a", 1)
+    m.Put("c", 3)
+
+    if previous, replaced := m.Put("b", 20); !replaced || previous != 2 {
+        t.Errorf("Put() expected previous (2, true), got (%d, %v)", previous, replaced)
+    }
+    if got, ok := m.Get("b"); !ok || got != 20 {
+        t.Errorf("Get(b) expected (20, true), got (%d, %v)", got, ok)
+    }
+    if _, ok := m.Get("z"); ok {
+        t.Error("Get() of a missing key should return false")
+    }
+    if m.Size() != 3 {
+        t.Errorf("Expected size 3, got %d", m.Size())
+    }
+}
+
+// TestTreeMapDelete tests removing keys.
+func TestTreeMapDelete(t *testing.T) {
+    m := NewTreeMap[int, string](cmp.Compare[int])
+    m.Put(1, "one")
+    m.Put(2, "two")
+
+    if got, ok := m.Delete(1); !ok || got != "one" {
+        t.Errorf("Delete(1) expected (one, true), got (%s, %v)", got, ok)
+    }
+    if _, ok := m.Delete(1); ok {
+        t.Error("Delete() of a missing key should return false")
+    }
+    if _, ok := m.Get(1); ok {
+        t.Error("Get() should fail after Delete")
+    }
+    m.Clear()
+    if !m.IsEmpty() {
+        t.Error("Map should be empty after Clear")
+    }
+}
+
+// TestTreeMapAllInKeyOrder tests that iteration follows the key order, whatever the insertion order.
+func TestTreeMapAllInKeyOrder(t *testing.T) {
+    m := NewTreeMap[int, int](cmp.Compare[int])
+    for _, k := range rand.New(rand.NewSource(42)).Perm(100) {
+        m.Put(k, k*k)
+    }
+    for k := range 100 {
+        if k%3 == 0 {
+            m.Delete(k)
+        }
+    }
+
+    prev := -1
+    for k, v := range m.All() {
+        if k <= prev || k%3 == 0 || v != k*k {
+            t.Fatalf("Unexpected entry (%d, %d) after key %d", k, v, prev)
+        }
+        prev = k
+    }
+    assertRedBlackEntries(t, m)
+}
+
+// assertRedBlackEntries checks the red-black invariants of the tree backing a map
+func assertRedBlackEntries(t *testing.T, m *TreeMap[int, int]) {
+    t.Helper()
+    keys := NewRedBlackTree[int]()
+    keys.root = copyKeys(m.tree.root)
+    keys.size = m.Size()
+    assertRedBlack(t, keys)
+}
+
+// copyKeys copies the shape and colors of an entry tree into a tree of keys
+func copyKeys(node *Node[Entry[int, int]]) *Node[int] {
+    if node == nil {
+        return nil
+    }
+    return &Node[int]{value: node.value.Key, left: copyKeys(node.left), right: copyKeys(node.right), red: node.red}
+}
+
+// TestTreeMapStructKeys tests keys that are not ordered types, with a custom comparator.
+func TestTreeMapStructKeys(t *testing.T) {
+    type point struct{ x, y int }
+    byXThenY := func(a, b point) int {
+        return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+    }
+
+    m := NewTreeMap[point, string](byXThenY)
+    m.Put(point{2, 1}, "c")
+    m.Put(point{1, 5}, "b")
+    m.Put(point{1, 2}, "a")
+
+    if !m.ContainsKey(point{1, 5}) || m.ContainsKey(point{5, 1}) {
+        t.Error("ContainsKey() should only find present keys")
+    }
+
+    expectedKeys := []point{{1, 2}, {1, 5}, {2, 1}}
+    if got := slices.Collect(m.Keys()); !slices.Equal(got, expectedKeys) {
+        t.Errorf("Keys() expected %v, got %v", expectedKeys, got)
+    }
+    expectedValues := []string{"a", "b", "c"}
+    if got := slices.Collect(m.Values()); !slices.Equal(got, expectedValues) {
+        t.Errorf("Values() expected %v, got %v", expectedValues, got)
+    }
+    expectedEntries := []Entry[point, string]{{point{1, 2}, "a"}, {point{1, 5}, "b"}, {point{2, 1}, "c"}}
+    if got := slices.Collect(m.Entries()); !slices.Equal(got, expectedEntries) {
+        t.Errorf("Entries() expected %v, got %v", expectedEntries, got)
+    }
+}
+
+// TestTreeMapReverseComparator tests that the comparator alone defines the order.
+func TestTreeMapReverseComparator(t *testing.T) {
+    m := NewTreeMap[int, bool](func(a, b int) int { return cmp.Compare(b, a) })
+    for _, k := range []int{2, 3, 1} {
+        m.Put(k, true)
+    }
+
+    it := m.Iterator()
+    for _, expected := range []int{3, 2, 1} {
+        if got := it.Next(); got.Key != expected {
+            t.Errorf("Expected key %d, got %d", expected, got.Key)
+        }
+    }
+    if it.HasNext() {
+        t.Error("HasNext() should be false after the last entry")
+    }
+}
+
+// TestTreeMapIterationBreak tests stopping the sequences early.
+func TestTreeMapIterationBreak(t *testing.T) {
+    m := NewTreeMap[int, int](cmp.Compare[int])
+    for k := range 10 {
+        m.Put(k, k)
+    }
+    for range m.Keys() {
+        break
+    }
+    for range m.Values() {
+        break
+    }
+    count := 0
+    for k := range m.All() {
+        if k == 3 {
+            break
+        }
+        count++
+    }
+    if count != 3 {
+        t.Errorf("Expected 3 entries before break, got %d", count)
+    }
+}
@@ -0,0 +1,84 @@
+package tree
+
+import (
+    "iter"
+)
+
+// TreeSet is an ordered set, stored in a RedBlackTree. Like TreeMap, it accepts any element type
+// ordered by a comparator, and iterates in ascending order. Every operation is O(log n).
+//
+//   type version struct{ major, minor int }
+//
+//   versions := NewTreeSet(func(a, b version) int {
+//       return cmp.Or(cmp.Compare(a.major, b.major), cmp.Compare(a.minor, b.minor))
+//   })
+//   versions.Add(version{1, 10})
+//   versions.Add(version{1, 2})
+//   latest, _ := versions.Max() // {1 10}
+type TreeSet[T any] struct {
+    tree *RedBlackTree[T]
+}
+
+// NewTreeSet creates and returns a new empty TreeSet ordering its elements with comparator,
+// e.g. cmp.Compare[int] for ordered elements.
+func NewTreeSet[T any](comparator func(a, b T) int) *TreeSet[T] {
+    return &TreeSet[T]{tree: newRedBlackTreeFunc(comparator)}
+}
+
+// Add inserts value. Returns false if an equal element was already present, which is kept.
+//
+// Time complexity: O(log n)
+func (s *TreeSet[T]) Add(value T) bool {
+    _, added := s.tree.put(value)
+    return added
+}
+
+// Contains returns true if an element equal to value is present.
+//
+// Time complexity: O(log n)
+func (s *TreeSet[T]) Contains(value T) bool {
+    return s.tree.find(value) != nil
+}
+
+// Remove deletes the element equal to value. Returns false if there was none.
+//
+// Time complexity: O(log n)
+func (s *TreeSet[T]) Remove(value T) bool {
+    _, removed := s.tree.Remove(value)
+    return removed
+}
+
+// Size returns the number of elements.
+func (s *TreeSet[T]) Size() int {
+    return s.tree.Size()
+}
+
+// IsEmpty returns true if the set has no elements.
+func (s *TreeSet[T]) IsEmpty() bool {
+    return s.tree.Size() == 0
+}
+
+// Clear removes all the elements.
+func (s *TreeSet[T]) Clear() {
+    s.tree.Clear()
+}
+
+// Min returns the smallest element, and false if the set is empty.
+func (s *TreeSet[T]) Min() (T, bool) {
+    return s.tree.Min()
+}
+
+// Max returns the largest element, and false if the set is empty.
+func (s *TreeSet[T]) Max() (T, bool) {
+    return s.tree.Max()
+}
+
+// Iterator returns an iterator over the elements in ascending order.
+func (s *TreeSet[T]) Iterator() Iterator[T] {
+    return s.tree.Iterator()
+}
+
+// All returns a sequence over the elements in ascending order, for use with range.
+func (s *TreeSet[T]) All() iter.Seq[T] {
+    return s.tree.All()
+}
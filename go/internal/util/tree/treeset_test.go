@@ -0,0 +1,90 @@
+package tree
+
+import (
+    "cmp"
+    "slices"
+    "strings"
+    "testing"
+)
+
+// TestTreeSetAddContainsRemove tests the basic set operations.
+func TestTreeSetAddContainsRemove(t *testing.T) {
+    s := NewTreeSet(cmp.Compare[int])
+    if !s.IsEmpty() {
+        t.Error("New set should be empty")
+    }
+
+    for _, v := range []int{5, 1, 3} {
+        if !s.Add(v) {
+            t.Errorf("Add(%d) of a new element should return true", v)
+        }
+    }
+    if s.Add(3) {
+        t.Error("Add() of a present element should return false")
+    }
+    if !s.Contains(1) || s.Contains(2) {
+        t.Error("Contains() should only find present elements")
+    }
+
+    if !s.Remove(1) || s.Remove(1) {
+        t.Error("Remove() should return true only the first time")
+    }
+    expected := []int{3, 5}
+    if got := slices.Collect(s.All()); !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+    if s.Size() != 2 {
+        t.Errorf("Expected size 2, got %d", s.Size())
+    }
+
+    s.Clear()
+    if !s.IsEmpty() || s.Iterator().HasNext() {
+        t.Error("Set should be empty after Clear")
+    }
+}
+
+// TestTreeSetComparator tests a set of structs with a custom comparator, where elements the
+// comparator considers equal are duplicates.
+func TestTreeSetComparator(t *testing.T) {
+    type version struct {
+        major, minor int
+        tag          string
+    }
+    versions := NewTreeSet(func(a, b version) int {
+        return cmp.Or(cmp.Compare(a.major, b.major), cmp.Compare(a.minor, b.minor))
+    })
+
+    versions.Add(version{1, 10, "first"})
+    versions.Add(version{1, 2, ""})
+    versions.Add(version{0, 9, ""})
+    if versions.Add(version{1, 10, "second"}) {
+        t.Error("Add() of an equal element should return false")
+    }
+
+    if latest, _ := versions.Max(); latest != (version{1, 10, "first"}) {
+        t.Errorf("Max() expected {1 10 first}, got %v", latest)
+    }
+    if oldest, _ := versions.Min(); oldest != (version{0, 9, ""}) {
+        t.Errorf("Min() expected {0 9}, got %v", oldest)
+    }
+}
+
+// TestTreeSetCaseInsensitive tests a comparator that is not a plain ordering of the values.
+func TestTreeSetCaseInsensitive(t *testing.T) {
+    s := NewTreeSet(func(a, b string) int {
+        return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+    })
+    for _, v := range []string{"Banana", "apple", "APPLE", "cherry", "banana"} {
+        s.Add(v)
+    }
+
+    expected := []string{"apple", "Banana", "cherry"}
+    if got := slices.Collect(s.All()); !slices.Equal(got, expected) {
+        t.Errorf("Expected %v, got %v", expected, got)
+    }
+
+    s.Remove("BANANA")
+    if s.Contains("banana") {
+        t.Error("Remove() should match with the comparator")
+    }
+}